@@ -2,6 +2,7 @@ package clients
 
 import (
 	"context"
+	"regexp"
 
 	"github.com/Azure/go-autorest/autorest"
 	"github.com/Azure/go-autorest/autorest/validation"
@@ -105,6 +106,12 @@ type Client struct {
 	Account  *ResourceManagerAccount
 	Features features.UserFeatures
 
+	// DefaultLocation is the Azure Region used for Resources which don't specify a `location`
+	DefaultLocation string
+
+	// NamingPolicies are the patterns which the `name` of each Resource Type must match, keyed by Resource Type
+	NamingPolicies map[string]*regexp.Regexp
+
 	Advisor               *advisor.Client
 	AnalysisServices      *analysisServices.Client
 	ApiManagement         *apiManagement.Client
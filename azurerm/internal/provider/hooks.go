@@ -0,0 +1,165 @@
+package provider
+
+import (
+	"fmt"
+	"regexp"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/clients"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/location"
+)
+
+func schemaNamingPolicy() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"resource_type": {
+					Type:         schema.TypeString,
+					Required:     true,
+					ValidateFunc: validation.StringIsNotEmpty,
+				},
+
+				"pattern": {
+					Type:         schema.TypeString,
+					Required:     true,
+					ValidateFunc: validation.StringIsValidRegExp,
+				},
+			},
+		},
+		Description: "Regular expressions which the `name` of each Resource Type must match.",
+	}
+}
+
+func expandNamingPolicies(input []interface{}, resources map[string]*schema.Resource) (map[string]*regexp.Regexp, error) {
+	policies := make(map[string]*regexp.Regexp)
+
+	for _, item := range input {
+		if item == nil {
+			continue
+		}
+		v := item.(map[string]interface{})
+
+		resourceType := v["resource_type"].(string)
+		if _, exists := policies[resourceType]; exists {
+			return nil, fmt.Errorf("only one `naming_policy` can be specified for the Resource Type %q", resourceType)
+		}
+
+		resource, exists := resources[resourceType]
+		if !exists {
+			return nil, fmt.Errorf("the `naming_policy` Resource Type %q is not a Resource supported by this Provider", resourceType)
+		}
+		if _, hasName := resource.Schema["name"]; !hasName {
+			return nil, fmt.Errorf("the `naming_policy` Resource Type %q doesn't have a `name` which can be validated", resourceType)
+		}
+
+		pattern, err := regexp.Compile(v["pattern"].(string))
+		if err != nil {
+			return nil, fmt.Errorf("compiling the `naming_policy` pattern for the Resource Type %q: %+v", resourceType, err)
+		}
+
+		policies[resourceType] = pattern
+	}
+
+	return policies, nil
+}
+
+// resourcesSupportingDefaultLocation are the Resources which can fall back to the `default_location` defined
+// in the Provider block when a `location` isn't specified. This is opt-in, since making `location` Optional
+// changes the schema (and the behaviour when it's omitted) of each Resource.
+var resourcesSupportingDefaultLocation = map[string]struct{}{
+	"azurerm_key_vault":              {},
+	"azurerm_network_security_group": {},
+	"azurerm_public_ip":              {},
+	"azurerm_resource_group":         {},
+	"azurerm_storage_account":        {},
+	"azurerm_user_assigned_identity": {},
+	"azurerm_virtual_network":        {},
+}
+
+// withProviderHooks wraps the Resource so that the `default_location` and `naming_policy` defined in the
+// Provider block are applied at plan time. `meta` returns the configured Provider client, which is nil
+// until the Provider has been configured.
+func withProviderHooks(resourceType string, resource *schema.Resource, meta func() interface{}) {
+	_, hasName := resource.Schema["name"]
+
+	// Resources which require a `location` can instead fall back to the `default_location` defined in the
+	// Provider block. Since the Plugin SDK only calls the DefaultFunc when `location` is omitted from the
+	// config, a `location` which is specified but not yet known (e.g. interpolated from another Resource)
+	// is left as-is.
+	_, supportsDefaultLocation := resourcesSupportingDefaultLocation[resourceType]
+	loc, hasLocation := resource.Schema["location"]
+	hasLocation = supportsDefaultLocation && hasLocation && loc.Type == schema.TypeString && loc.Required
+	if hasLocation {
+		loc.Required = false
+		loc.Optional = true
+		loc.DefaultFunc = func() (interface{}, error) {
+			if client, ok := meta().(*clients.Client); ok && client != nil && client.DefaultLocation != "" {
+				return location.Normalize(client.DefaultLocation), nil
+			}
+
+			return nil, nil
+		}
+	}
+
+	if !hasName && !hasLocation {
+		return
+	}
+
+	existing := resource.CustomizeDiff
+	resource.CustomizeDiff = func(d *schema.ResourceDiff, meta interface{}) error {
+		client, ok := meta.(*clients.Client)
+		if !ok || client == nil {
+			// the Provider hasn't been configured (e.g. when validating)
+			if existing != nil {
+				return existing(d, meta)
+			}
+			return nil
+		}
+
+		// when `location` is omitted and there's no `default_location` to fall back to, it's known but empty
+		if hasLocation && d.NewValueKnown("location") && d.Get("location").(string) == "" {
+			return fmt.Errorf("`location` must be specified either on the Resource or as the `default_location` in the Provider block")
+		}
+
+		// since `location` is ForceNew, changing the `default_location` would otherwise replace every existing
+		// Resource which falls back to it. The Plugin SDK doesn't expose whether `location` is specified in the
+		// config, so a change to the `default_location` on an existing Resource is refused instead
+		if hasLocation && d.Id() != "" && client.DefaultLocation != "" && d.HasChange("location") && d.NewValueKnown("location") {
+			oldLocation, newLocation := d.GetChange("location")
+			defaultLocation := location.Normalize(client.DefaultLocation)
+			if location.Normalize(newLocation.(string)) == defaultLocation && location.Normalize(oldLocation.(string)) != defaultLocation {
+				return fmt.Errorf("the `location` of this Resource would change from %q to the `default_location` %q, which requires the Resource to be replaced - either specify `location = %q` on the Resource to keep it where it is, or taint the Resource to move it", oldLocation.(string), defaultLocation, oldLocation.(string))
+			}
+		}
+
+		if hasName && d.NewValueKnown("name") {
+			if name, ok := d.Get("name").(string); ok {
+				if err := validateNameAgainstNamingPolicy(resourceType, name, client.NamingPolicies); err != nil {
+					return err
+				}
+			}
+		}
+
+		if existing != nil {
+			return existing(d, meta)
+		}
+
+		return nil
+	}
+}
+
+func validateNameAgainstNamingPolicy(resourceType, name string, policies map[string]*regexp.Regexp) error {
+	pattern, ok := policies[resourceType]
+	if !ok {
+		return nil
+	}
+
+	if !pattern.MatchString(name) {
+		return fmt.Errorf("the name %q does not match the `naming_policy` defined for %q in the Provider block (%q)", name, resourceType, pattern.String())
+	}
+
+	return nil
+}
@@ -0,0 +1,386 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/clients"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/location"
+)
+
+func TestExpandNamingPolicies(t *testing.T) {
+	resources := map[string]*schema.Resource{
+		"azurerm_resource_group": {
+			Schema: map[string]*schema.Schema{
+				"name": {
+					Type:     schema.TypeString,
+					Required: true,
+				},
+			},
+		},
+		"azurerm_storage_account": {
+			Schema: map[string]*schema.Schema{
+				"name": {
+					Type:     schema.TypeString,
+					Required: true,
+				},
+			},
+		},
+		"azurerm_example_association": {
+			Schema: map[string]*schema.Schema{
+				"example_id": {
+					Type:     schema.TypeString,
+					Required: true,
+				},
+			},
+		},
+	}
+
+	testData := []struct {
+		Name     string
+		Input    []interface{}
+		Expected map[string]string
+		Error    bool
+	}{
+		{
+			Name:     "Empty",
+			Input:    []interface{}{},
+			Expected: map[string]string{},
+		},
+		{
+			Name: "Multiple Resource Types",
+			Input: []interface{}{
+				map[string]interface{}{
+					"resource_type": "azurerm_resource_group",
+					"pattern":       "^rg-",
+				},
+				map[string]interface{}{
+					"resource_type": "azurerm_storage_account",
+					"pattern":       "^st[a-z0-9]+$",
+				},
+			},
+			Expected: map[string]string{
+				"azurerm_resource_group":  "^rg-",
+				"azurerm_storage_account": "^st[a-z0-9]+$",
+			},
+		},
+		{
+			Name: "Duplicate Resource Type",
+			Input: []interface{}{
+				map[string]interface{}{
+					"resource_type": "azurerm_resource_group",
+					"pattern":       "^rg-",
+				},
+				map[string]interface{}{
+					"resource_type": "azurerm_resource_group",
+					"pattern":       "^resourcegroup-",
+				},
+			},
+			Error: true,
+		},
+		{
+			Name: "Unknown Resource Type",
+			Input: []interface{}{
+				map[string]interface{}{
+					"resource_type": "azurerm_resoruce_group",
+					"pattern":       "^rg-",
+				},
+			},
+			Error: true,
+		},
+		{
+			Name: "Resource Type without a Name",
+			Input: []interface{}{
+				map[string]interface{}{
+					"resource_type": "azurerm_example_association",
+					"pattern":       "^example-",
+				},
+			},
+			Error: true,
+		},
+		{
+			Name: "Invalid Pattern",
+			Input: []interface{}{
+				map[string]interface{}{
+					"resource_type": "azurerm_resource_group",
+					"pattern":       "^rg-(",
+				},
+			},
+			Error: true,
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q..", v.Name)
+
+		actual, err := expandNamingPolicies(v.Input, resources)
+		if err != nil {
+			if v.Error {
+				continue
+			}
+
+			t.Fatalf("Expected no error but got: %+v", err)
+		}
+
+		if v.Error {
+			t.Fatalf("Expected an error but didn't get one")
+		}
+
+		if len(actual) != len(v.Expected) {
+			t.Fatalf("Expected %d Naming Policies but got %d", len(v.Expected), len(actual))
+		}
+
+		for resourceType, pattern := range v.Expected {
+			if actual[resourceType] == nil || actual[resourceType].String() != pattern {
+				t.Fatalf("Expected the pattern %q for %q but got %+v", pattern, resourceType, actual[resourceType])
+			}
+		}
+	}
+}
+
+func TestValidateNameAgainstNamingPolicy(t *testing.T) {
+	resources := map[string]*schema.Resource{
+		"azurerm_resource_group": {
+			Schema: map[string]*schema.Schema{
+				"name": {
+					Type:     schema.TypeString,
+					Required: true,
+				},
+			},
+		},
+	}
+	policies, err := expandNamingPolicies([]interface{}{
+		map[string]interface{}{
+			"resource_type": "azurerm_resource_group",
+			"pattern":       "^rg-[a-z]+$",
+		},
+	}, resources)
+	if err != nil {
+		t.Fatalf("expanding Naming Policies: %+v", err)
+	}
+
+	testData := []struct {
+		ResourceType string
+		Name         string
+		Valid        bool
+	}{
+		{
+			ResourceType: "azurerm_resource_group",
+			Name:         "rg-example",
+			Valid:        true,
+		},
+		{
+			ResourceType: "azurerm_resource_group",
+			Name:         "example-resources",
+			Valid:        false,
+		},
+		{
+			// no policy is defined for this Resource Type
+			ResourceType: "azurerm_storage_account",
+			Name:         "example-resources",
+			Valid:        true,
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q (%s)..", v.Name, v.ResourceType)
+
+		err := validateNameAgainstNamingPolicy(v.ResourceType, v.Name, policies)
+		if v.Valid && err != nil {
+			t.Fatalf("Expected %q to be valid but got: %+v", v.Name, err)
+		}
+		if !v.Valid && err == nil {
+			t.Fatalf("Expected %q to be invalid but it was valid", v.Name)
+		}
+	}
+}
+
+func TestWithProviderHooksDefaultLocation(t *testing.T) {
+	// a `location` which is specified but not yet known (e.g. interpolated from another Resource which
+	// hasn't been created yet) is surfaced by the Plugin SDK as this placeholder
+	unknownValue := "74D93920-ED26-11E3-AC10-0800200C9A66"
+
+	testData := []struct {
+		Name             string
+		ResourceType     string
+		Config           map[string]interface{}
+		State            map[string]string
+		DefaultLocation  string
+		ExpectedLocation string
+		ExpectedUnknown  bool
+		ExpectNoChange   bool
+		Error            bool
+	}{
+		{
+			Name:         "Location specified on the Resource",
+			ResourceType: "azurerm_resource_group",
+			Config: map[string]interface{}{
+				"name":     "example",
+				"location": "westus",
+			},
+			DefaultLocation:  "West Europe",
+			ExpectedLocation: "westus",
+		},
+		{
+			Name:         "Location omitted with a Default Location",
+			ResourceType: "azurerm_resource_group",
+			Config: map[string]interface{}{
+				"name": "example",
+			},
+			DefaultLocation:  "West Europe",
+			ExpectedLocation: "westeurope",
+		},
+		{
+			Name:         "Location not yet known with a Default Location",
+			ResourceType: "azurerm_resource_group",
+			Config: map[string]interface{}{
+				"name":     "example",
+				"location": unknownValue,
+			},
+			DefaultLocation: "West Europe",
+			ExpectedUnknown: true,
+		},
+		{
+			Name:         "Location not yet known without a Default Location",
+			ResourceType: "azurerm_resource_group",
+			Config: map[string]interface{}{
+				"name":     "example",
+				"location": unknownValue,
+			},
+			ExpectedUnknown: true,
+		},
+		{
+			Name:         "Location omitted without a Default Location",
+			ResourceType: "azurerm_resource_group",
+			Config: map[string]interface{}{
+				"name": "example",
+			},
+			Error: true,
+		},
+		{
+			Name:         "Existing Resource using the Default Location",
+			ResourceType: "azurerm_resource_group",
+			Config: map[string]interface{}{
+				"name": "example",
+			},
+			State: map[string]string{
+				"name":     "example",
+				"location": "westeurope",
+			},
+			DefaultLocation: "West Europe",
+			ExpectNoChange:  true,
+		},
+		{
+			// changing the `default_location` would otherwise replace the existing Resource
+			Name:         "Existing Resource when the Default Location changes",
+			ResourceType: "azurerm_resource_group",
+			Config: map[string]interface{}{
+				"name": "example",
+			},
+			State: map[string]string{
+				"name":     "example",
+				"location": "westeurope",
+			},
+			DefaultLocation: "North Europe",
+			Error:           true,
+		},
+		{
+			Name:         "Existing Resource when the Location specified on the Resource changes",
+			ResourceType: "azurerm_resource_group",
+			Config: map[string]interface{}{
+				"name":     "example",
+				"location": "westus",
+			},
+			State: map[string]string{
+				"name":     "example",
+				"location": "westeurope",
+			},
+			DefaultLocation:  "North Europe",
+			ExpectedLocation: "westus",
+		},
+		{
+			Name:         "Resource which doesn't support a Default Location",
+			ResourceType: "azurerm_example",
+			Config: map[string]interface{}{
+				"name": "example",
+			},
+			DefaultLocation: "West Europe",
+			Error:           true,
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q..", v.Name)
+
+		resource := &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"name": {
+					Type:     schema.TypeString,
+					Required: true,
+				},
+				"location": location.Schema(),
+			},
+		}
+
+		// the Provider is configured after the schema has been validated
+		var meta interface{}
+		withProviderHooks(v.ResourceType, resource, func() interface{} {
+			return meta
+		})
+
+		config := terraform.NewResourceConfigRaw(v.Config)
+
+		// the schema is validated prior to the plan, which is where a missing Required `location` is caught
+		if _, errs := resource.Validate(config); len(errs) > 0 {
+			if v.Error {
+				continue
+			}
+			t.Fatalf("Expected no validation errors but got: %+v", errs)
+		}
+
+		client := &clients.Client{
+			DefaultLocation: v.DefaultLocation,
+		}
+		meta = client
+
+		var state *terraform.InstanceState
+		if v.State != nil {
+			state = &terraform.InstanceState{
+				ID:         "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/example",
+				Attributes: v.State,
+			}
+		}
+
+		diff, err := resource.Diff(state, config, client)
+		if err != nil {
+			if v.Error {
+				continue
+			}
+			t.Fatalf("Expected no error but got: %+v", err)
+		}
+
+		if v.Error {
+			t.Fatalf("Expected an error but didn't get one")
+		}
+
+		if v.ExpectNoChange {
+			if diff != nil && diff.Attributes["location"] != nil {
+				t.Fatalf("Expected no change to `location` but got %+v", diff.Attributes["location"])
+			}
+			continue
+		}
+
+		actual := diff.Attributes["location"]
+		if v.ExpectedUnknown {
+			if actual == nil || !actual.NewComputed {
+				t.Fatalf("Expected `location` to be unknown but got %+v", actual)
+			}
+			continue
+		}
+
+		if actual == nil || actual.NewComputed || actual.New != v.ExpectedLocation {
+			t.Fatalf("Expected `location` to be %q but got %+v", v.ExpectedLocation, actual)
+		}
+	}
+}
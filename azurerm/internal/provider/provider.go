@@ -214,6 +214,15 @@ func azureProvider(supportLegacyTestSuite bool) terraform.ResourceProvider {
 
 			"features": schemaFeatures(supportLegacyTestSuite),
 
+			"default_location": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+				Description:  "The Azure Region which should be used for Resources which don't specify a `location`.",
+			},
+
+			"naming_policy": schemaNamingPolicy(),
+
 			// Advanced feature flags
 			"skip_provider_registration": {
 				Type:        schema.TypeBool,
@@ -243,6 +252,11 @@ func azureProvider(supportLegacyTestSuite bool) terraform.ResourceProvider {
 		}
 	}
 
+	// apply the `default_location` and `naming_policy` from the Provider block to each Resource
+	for k, v := range resources {
+		withProviderHooks(k, v, p.Meta)
+	}
+
 	p.ConfigureFunc = providerConfigure(p)
 
 	return p
@@ -305,6 +319,11 @@ func providerConfigure(p *schema.Provider) schema.ConfigureFunc {
 			terraformVersion = "0.11+compatible"
 		}
 
+		namingPolicies, err := expandNamingPolicies(d.Get("naming_policy").([]interface{}), p.ResourcesMap)
+		if err != nil {
+			return nil, err
+		}
+
 		skipProviderRegistration := d.Get("skip_provider_registration").(bool)
 		clientBuilder := clients.ClientBuilder{
 			AuthConfig:                  config,
@@ -326,6 +345,8 @@ func providerConfigure(p *schema.Provider) schema.ConfigureFunc {
 		}
 
 		client.StopContext = p.StopContext()
+		client.DefaultLocation = d.Get("default_location").(string)
+		client.NamingPolicies = namingPolicies
 
 		if !skipProviderRegistration {
 			// List all the available providers and their registration state to avoid unnecessary
@@ -82,9 +82,18 @@ func resourceApiManagementLogger() *schema.Resource {
 					Schema: map[string]*schema.Schema{
 						"instrumentation_key": {
 							Type:         schema.TypeString,
-							Required:     true,
+							Optional:     true,
 							Sensitive:    true,
 							ValidateFunc: validation.StringIsNotEmpty,
+							ExactlyOneOf: []string{"application_insights.0.instrumentation_key", "application_insights.0.connection_string"},
+						},
+
+						"connection_string": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Sensitive:    true,
+							ValidateFunc: validation.StringIsNotEmpty,
+							ExactlyOneOf: []string{"application_insights.0.instrumentation_key", "application_insights.0.connection_string"},
 						},
 					},
 				},
@@ -275,7 +284,12 @@ func expandApiManagementLoggerEventHub(input []interface{}) map[string]*string {
 func expandApiManagementLoggerApplicationInsights(input []interface{}) map[string]*string {
 	credentials := make(map[string]*string)
 	ai := input[0].(map[string]interface{})
-	credentials["instrumentationKey"] = utils.String(ai["instrumentation_key"].(string))
+	if v := ai["instrumentation_key"].(string); v != "" {
+		credentials["instrumentationKey"] = utils.String(v)
+	}
+	if v := ai["connection_string"].(string); v != "" {
+		credentials["connectionString"] = utils.String(v)
+	}
 	return credentials
 }
 
@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"time"
 
+	"github.com/Azure/azure-sdk-for-go/services/compute/mgmt/2020-12-01/compute"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/clients"
 
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
@@ -38,6 +39,13 @@ func dataSourceDedicatedHost() *schema.Resource {
 
 			"location": azure.SchemaLocationForDataSource(),
 
+			"platform_fault_domain": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+
+			"available_capacity": schemaDedicatedHostAvailableCapacity(),
+
 			"tags": tags.SchemaDataSource(),
 		},
 	}
@@ -52,7 +60,7 @@ func dataSourceDedicatedHostRead(d *schema.ResourceData, meta interface{}) error
 	resourceGroupName := d.Get("resource_group_name").(string)
 	hostGroupName := d.Get("dedicated_host_group_name").(string)
 
-	resp, err := client.Get(ctx, resourceGroupName, hostGroupName, name, "")
+	resp, err := client.Get(ctx, resourceGroupName, hostGroupName, name, compute.InstanceView)
 	if err != nil {
 		if utils.ResponseWasNotFound(resp.Response) {
 			return fmt.Errorf("Error: Dedicated Host %q (Host Group Name %q / Resource Group %q) was not found", name, hostGroupName, resourceGroupName)
@@ -68,5 +76,19 @@ func dataSourceDedicatedHostRead(d *schema.ResourceData, meta interface{}) error
 	}
 	d.Set("dedicated_host_group_name", hostGroupName)
 
+	platformFaultDomain := 0
+	var instanceView *compute.DedicatedHostInstanceView
+	if props := resp.DedicatedHostProperties; props != nil {
+		if props.PlatformFaultDomain != nil {
+			platformFaultDomain = int(*props.PlatformFaultDomain)
+		}
+		instanceView = props.InstanceView
+	}
+	d.Set("platform_fault_domain", platformFaultDomain)
+
+	if err := d.Set("available_capacity", flattenDedicatedHostAvailableCapacity(instanceView)); err != nil {
+		return fmt.Errorf("setting `available_capacity`: %+v", err)
+	}
+
 	return tags.FlattenAndSet(d, resp.Tags)
 }
@@ -22,6 +22,8 @@ func TestAccDataSourceAzureRMDedicatedHost_basic(t *testing.T) {
 			Check: resource.ComposeTestCheckFunc(
 				check.That(data.ResourceName).Key("location").Exists(),
 				check.That(data.ResourceName).Key("tags.%").Exists(),
+				check.That(data.ResourceName).Key("platform_fault_domain").HasValue("1"),
+				check.That(data.ResourceName).Key("available_capacity.#").Exists(),
 			),
 		},
 	})
@@ -57,6 +57,13 @@ func resourceDedicatedHostGroup() *schema.Resource {
 				ValidateFunc: validation.IntBetween(1, 3),
 			},
 
+			"automatic_placement_enabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				ForceNew: true,
+				Default:  false,
+			},
+
 			// Currently only one endpoint is allowed.
 			// we'll leave this open to enhancement when they add multiple zones support.
 			"zones": azure.SchemaSingleZone(),
@@ -93,7 +100,8 @@ func resourceDedicatedHostGroupCreate(d *schema.ResourceData, meta interface{})
 	parameters := compute.DedicatedHostGroup{
 		Location: utils.String(location),
 		DedicatedHostGroupProperties: &compute.DedicatedHostGroupProperties{
-			PlatformFaultDomainCount: utils.Int32(int32(platformFaultDomainCount)),
+			PlatformFaultDomainCount:  utils.Int32(int32(platformFaultDomainCount)),
+			SupportAutomaticPlacement: utils.Bool(d.Get("automatic_placement_enabled").(bool)),
 		},
 		Tags: tags.Expand(t),
 	}
@@ -150,6 +158,12 @@ func resourceDedicatedHostGroupRead(d *schema.ResourceData, meta interface{}) er
 			platformFaultDomainCount = int(*props.PlatformFaultDomainCount)
 		}
 		d.Set("platform_fault_domain_count", platformFaultDomainCount)
+
+		automaticPlacementEnabled := false
+		if props.SupportAutomaticPlacement != nil {
+			automaticPlacementEnabled = *props.SupportAutomaticPlacement
+		}
+		d.Set("automatic_placement_enabled", automaticPlacementEnabled)
 	}
 	d.Set("zones", utils.FlattenStringSlice(resp.Zones))
 
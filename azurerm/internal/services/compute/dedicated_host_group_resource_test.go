@@ -59,6 +59,7 @@ func TestAccDedicatedHostGroup_complete(t *testing.T) {
 				check.That(data.ResourceName).Key("zones.#").HasValue("1"),
 				check.That(data.ResourceName).Key("zones.0").HasValue("1"),
 				check.That(data.ResourceName).Key("platform_fault_domain_count").HasValue("2"),
+				check.That(data.ResourceName).Key("automatic_placement_enabled").HasValue("true"),
 				check.That(data.ResourceName).Key("tags.ENV").HasValue("prod"),
 			),
 		},
@@ -130,6 +131,7 @@ resource "azurerm_dedicated_host_group" "test" {
   resource_group_name         = azurerm_resource_group.test.name
   location                    = azurerm_resource_group.test.location
   platform_fault_domain_count = 2
+  automatic_placement_enabled = true
   zones                       = ["1"]
   tags = {
     ENV = "prod"
@@ -120,6 +120,8 @@ func resourceDedicatedHost() *schema.Resource {
 				Default: string(compute.DedicatedHostLicenseTypesNone),
 			},
 
+			"available_capacity": schemaDedicatedHostAvailableCapacity(),
+
 			"tags": tags.Schema(),
 		},
 	}
@@ -206,7 +208,7 @@ func resourceDedicatedHostRead(d *schema.ResourceData, meta interface{}) error {
 		return fmt.Errorf("Error retrieving Dedicated Host Group %q (Resource Group %q): %+v", id.HostGroupName, id.ResourceGroup, err)
 	}
 
-	resp, err := hostsClient.Get(ctx, id.ResourceGroup, id.HostGroupName, id.HostName, "")
+	resp, err := hostsClient.Get(ctx, id.ResourceGroup, id.HostGroupName, id.HostName, compute.InstanceView)
 	if err != nil {
 		if utils.ResponseWasNotFound(resp.Response) {
 			log.Printf("[INFO] Dedicated Host %q does not exist - removing from state", d.Id())
@@ -233,6 +235,10 @@ func resourceDedicatedHostRead(d *schema.ResourceData, meta interface{}) error {
 			platformFaultDomain = int(*props.PlatformFaultDomain)
 		}
 		d.Set("platform_fault_domain", platformFaultDomain)
+
+		if err := d.Set("available_capacity", flattenDedicatedHostAvailableCapacity(props.InstanceView)); err != nil {
+			return fmt.Errorf("setting `available_capacity`: %+v", err)
+		}
 	}
 
 	return tags.FlattenAndSet(d, resp.Tags)
@@ -320,3 +326,49 @@ func dedicatedHostDeletedRefreshFunc(ctx context.Context, client *compute.Dedica
 		return res, "Exists", nil
 	}
 }
+
+func schemaDedicatedHostAvailableCapacity() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Computed: true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"vm_size": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+
+				"count": {
+					Type:     schema.TypeInt,
+					Computed: true,
+				},
+			},
+		},
+	}
+}
+
+func flattenDedicatedHostAvailableCapacity(input *compute.DedicatedHostInstanceView) []interface{} {
+	results := make([]interface{}, 0)
+	if input == nil || input.AvailableCapacity == nil || input.AvailableCapacity.AllocatableVMs == nil {
+		return results
+	}
+
+	for _, vm := range *input.AvailableCapacity.AllocatableVMs {
+		vmSize := ""
+		if vm.VMSize != nil {
+			vmSize = *vm.VMSize
+		}
+
+		count := 0
+		if vm.Count != nil {
+			count = int(*vm.Count)
+		}
+
+		results = append(results, map[string]interface{}{
+			"vm_size": vmSize,
+			"count":   count,
+		})
+	}
+
+	return results
+}
@@ -26,6 +26,8 @@ func TestAccDedicatedHost_basic(t *testing.T) {
 			Config: r.basic(data),
 			Check: resource.ComposeTestCheckFunc(
 				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("available_capacity.0.vm_size").Exists(),
+				check.That(data.ResourceName).Key("available_capacity.0.count").Exists(),
 			),
 		},
 		data.ImportStep(),
@@ -0,0 +1,63 @@
+package compute
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/Azure/azure-sdk-for-go/services/compute/mgmt/2020-12-01/compute"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func TestFlattenDedicatedHostAvailableCapacity(t *testing.T) {
+	cases := []struct {
+		Name     string
+		Input    *compute.DedicatedHostInstanceView
+		Expected []interface{}
+	}{
+		{
+			Name:     "No Instance View",
+			Input:    nil,
+			Expected: []interface{}{},
+		},
+		{
+			Name:     "No Available Capacity",
+			Input:    &compute.DedicatedHostInstanceView{},
+			Expected: []interface{}{},
+		},
+		{
+			Name: "Allocatable VMs",
+			Input: &compute.DedicatedHostInstanceView{
+				AvailableCapacity: &compute.DedicatedHostAvailableCapacity{
+					AllocatableVMs: &[]compute.DedicatedHostAllocatableVM{
+						{
+							VMSize: utils.String("Standard_D2s_v3"),
+							Count:  utils.Float(32),
+						},
+						{
+							VMSize: utils.String("Standard_D64s_v3"),
+						},
+					},
+				},
+			},
+			Expected: []interface{}{
+				map[string]interface{}{
+					"vm_size": "Standard_D2s_v3",
+					"count":   32,
+				},
+				map[string]interface{}{
+					"vm_size": "Standard_D64s_v3",
+					"count":   0,
+				},
+			},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Logf("[DEBUG] Testing %q..", tc.Name)
+
+		actual := flattenDedicatedHostAvailableCapacity(tc.Input)
+		if !reflect.DeepEqual(tc.Expected, actual) {
+			t.Fatalf("expected %+v but got %+v", tc.Expected, actual)
+		}
+	}
+}
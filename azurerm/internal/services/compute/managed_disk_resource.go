@@ -1,12 +1,15 @@
 package compute
 
 import (
+	"context"
+	"errors"
 	"fmt"
 	"log"
 	"strings"
 	"time"
 
 	"github.com/Azure/azure-sdk-for-go/services/compute/mgmt/2020-12-01/compute"
+	azureRest "github.com/Azure/go-autorest/autorest/azure"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
@@ -75,6 +78,7 @@ func resourceManagedDisk() *schema.Resource {
 					string(compute.FromImage),
 					string(compute.Import),
 					string(compute.Restore),
+					string(compute.Upload),
 				}, false),
 			},
 
@@ -104,6 +108,14 @@ func resourceManagedDisk() *schema.Resource {
 				ForceNew: true,
 			},
 
+			"upload_size_bytes": {
+				Type:     schema.TypeInt,
+				Optional: true,
+				ForceNew: true,
+				// 20 MiB + 512 bytes for the VHD footer
+				ValidateFunc: validation.IntAtLeast(20972032),
+			},
+
 			"os_type": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -232,6 +244,16 @@ func resourceManagedDiskCreateUpdate(d *schema.ResourceData, meta interface{}) e
 
 		props.CreationData.SourceResourceID = utils.String(sourceResourceId)
 	}
+	if createOption == compute.Upload {
+		uploadSizeBytes := d.Get("upload_size_bytes").(int)
+		if uploadSizeBytes == 0 {
+			return fmt.Errorf("`upload_size_bytes` must be specified when `create_option` is set to `Upload`")
+		}
+
+		props.CreationData.UploadSizeBytes = utils.Int64(int64(uploadSizeBytes))
+	} else if d.Get("upload_size_bytes").(int) != 0 {
+		return fmt.Errorf("`upload_size_bytes` can only be specified when `create_option` is set to `Upload`")
+	}
 	if createOption == compute.FromImage {
 		imageReferenceId := d.Get("image_reference_id").(string)
 		if imageReferenceId == "" {
@@ -300,6 +322,7 @@ func resourceManagedDiskUpdate(d *schema.ResourceData, meta interface{}) error {
 	resourceGroup := d.Get("resource_group_name").(string)
 	storageAccountType := d.Get("storage_account_type").(string)
 	shouldShutDown := false
+	liveResize := false
 
 	disk, err := client.Get(ctx, resourceGroup, name)
 	if err != nil {
@@ -354,7 +377,12 @@ func resourceManagedDiskUpdate(d *schema.ResourceData, meta interface{}) error {
 
 	if d.HasChange("disk_size_gb") {
 		if old, new := d.GetChange("disk_size_gb"); new.(int) > old.(int) {
-			shouldShutDown = true
+			// data disks can (in most cases) be expanded whilst the Virtual Machine is running
+			if managedDiskSupportsNoDowntimeResize(disk, old.(int), new.(int)) {
+				liveResize = true
+			} else {
+				shouldShutDown = true
+			}
 			diskUpdate.DiskUpdateProperties.DiskSizeGB = utils.Int32(int32(new.(int)))
 		} else {
 			return fmt.Errorf("Error - New size must be greater than original size. Shrinking disks is not supported on Azure")
@@ -378,116 +406,14 @@ func resourceManagedDiskUpdate(d *schema.ResourceData, meta interface{}) error {
 		shouldShutDown = false
 	}
 
-	// if we are attached to a VM we bring down the VM as necessary for the operations which are not allowed while it's online
-	if shouldShutDown {
-		virtualMachine, err := parse.VirtualMachineID(*disk.ManagedBy)
-		if err != nil {
-			return fmt.Errorf("Error parsing VMID %q for disk attachment: %+v", *disk.ManagedBy, err)
-		}
-		// check instanceView State
-		vmClient := meta.(*clients.Client).Compute.VMClient
-
-		locks.ByName(name, virtualMachineResourceName)
-		defer locks.UnlockByName(name, virtualMachineResourceName)
-
-		instanceView, err := vmClient.InstanceView(ctx, virtualMachine.ResourceGroup, virtualMachine.Name)
-		if err != nil {
-			return fmt.Errorf("Error retrieving InstanceView for Virtual Machine %q (Resource Group %q): %+v", virtualMachine.Name, virtualMachine.ResourceGroup, err)
-		}
-
-		shouldTurnBackOn := true
-		shouldDeallocate := true
-
-		if instanceView.Statuses != nil {
-			for _, status := range *instanceView.Statuses {
-				if status.Code == nil {
-					continue
-				}
-
-				// could also be the provisioning state which we're not bothered with here
-				state := strings.ToLower(*status.Code)
-				if !strings.HasPrefix(state, "powerstate/") {
-					continue
-				}
-
-				state = strings.TrimPrefix(state, "powerstate/")
-				switch strings.ToLower(state) {
-				case "deallocated":
-				case "deallocating":
-					shouldTurnBackOn = false
-					shouldShutDown = false
-					shouldDeallocate = false
-				case "stopping":
-				case "stopped":
-					shouldShutDown = false
-					shouldTurnBackOn = false
-				}
-			}
-		}
-
-		// Shutdown
-		if shouldShutDown {
-			log.Printf("[DEBUG] Shutting Down Virtual Machine %q (Resource Group %q)..", virtualMachine.Name, virtualMachine.ResourceGroup)
-			forceShutdown := false
-			future, err := vmClient.PowerOff(ctx, virtualMachine.ResourceGroup, virtualMachine.Name, utils.Bool(forceShutdown))
-			if err != nil {
-				return fmt.Errorf("Error sending Power Off to Virtual Machine %q (Resource Group %q): %+v", virtualMachine.Name, virtualMachine.ResourceGroup, err)
-			}
-
-			if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
-				return fmt.Errorf("Error waiting for Power Off of Virtual Machine %q (Resource Group %q): %+v", virtualMachine.Name, virtualMachine.ResourceGroup, err)
-			}
-
-			log.Printf("[DEBUG] Shut Down Virtual Machine %q (Resource Group %q)..", virtualMachine.Name, virtualMachine.ResourceGroup)
-		}
-
-		// De-allocate
-		if shouldDeallocate {
-			log.Printf("[DEBUG] Deallocating Virtual Machine %q (Resource Group %q)..", virtualMachine.Name, virtualMachine.ResourceGroup)
-			deAllocFuture, err := vmClient.Deallocate(ctx, virtualMachine.ResourceGroup, virtualMachine.Name)
-			if err != nil {
-				return fmt.Errorf("Error Deallocating to Virtual Machine %q (Resource Group %q): %+v", virtualMachine.Name, virtualMachine.ResourceGroup, err)
-			}
-
-			if err := deAllocFuture.WaitForCompletionRef(ctx, client.Client); err != nil {
-				return fmt.Errorf("Error waiting for Deallocation of Virtual Machine %q (Resource Group %q): %+v", virtualMachine.Name, virtualMachine.ResourceGroup, err)
-			}
-
-			log.Printf("[DEBUG] Deallocated Virtual Machine %q (Resource Group %q)..", virtualMachine.Name, virtualMachine.ResourceGroup)
-		}
-
-		// Update Disk
-		updateFuture, err := client.Update(ctx, resourceGroup, name, diskUpdate)
-		if err != nil {
-			return fmt.Errorf("Error updating Managed Disk %q (Resource Group %q): %+v", name, resourceGroup, err)
-		}
-		if err := updateFuture.WaitForCompletionRef(ctx, client.Client); err != nil {
-			return fmt.Errorf("Error waiting for update of Managed Disk %q (Resource Group %q): %+v", name, resourceGroup, err)
-		}
-
-		if shouldTurnBackOn {
-			log.Printf("[DEBUG] Starting Linux Virtual Machine %q (Resource Group %q)..", virtualMachine.Name, virtualMachine.ResourceGroup)
-			future, err := vmClient.Start(ctx, virtualMachine.ResourceGroup, virtualMachine.Name)
-			if err != nil {
-				return fmt.Errorf("Error starting Virtual Machine %q (Resource Group %q): %+v", virtualMachine.Name, virtualMachine.ResourceGroup, err)
-			}
-
-			if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
-				return fmt.Errorf("Error waiting for start of Virtual Machine %q (Resource Group %q): %+v", virtualMachine.Name, virtualMachine.ResourceGroup, err)
-			}
-
-			log.Printf("[DEBUG] Started Virtual Machine %q (Resource Group %q)..", virtualMachine.Name, virtualMachine.ResourceGroup)
-		}
-	} else { // otherwise, just update it
-		diskFuture, err := client.Update(ctx, resourceGroup, name, diskUpdate)
-		if err != nil {
-			return fmt.Errorf("Error expanding managed disk %q (Resource Group %q): %+v", name, resourceGroup, err)
-		}
+	// resizing without downtime is only relevant when the disk is attached and nothing else requires a shut down
+	if disk.ManagedBy == nil || shouldShutDown {
+		liveResize = false
+	}
 
-		err = diskFuture.WaitForCompletionRef(ctx, client.Client)
-		if err != nil {
-			return fmt.Errorf("Error waiting for expand operation on managed disk %q (Resource Group %q): %+v", name, resourceGroup, err)
-		}
+	vmClient := meta.(*clients.Client).Compute.VMClient
+	if err := updateManagedDisk(ctx, client, vmClient, disk, resourceGroup, name, diskUpdate, shouldShutDown, liveResize); err != nil {
+		return err
 	}
 
 	return resourceManagedDiskRead(d, meta)
@@ -538,6 +464,12 @@ func resourceManagedDiskRead(d *schema.ResourceData, meta interface{}) error {
 			d.Set("source_resource_id", creationData.SourceResourceID)
 			d.Set("source_uri", creationData.SourceURI)
 			d.Set("storage_account_id", creationData.StorageAccountID)
+
+			uploadSizeBytes := 0
+			if creationData.UploadSizeBytes != nil {
+				uploadSizeBytes = int(*creationData.UploadSizeBytes)
+			}
+			d.Set("upload_size_bytes", uploadSizeBytes)
 		}
 
 		d.Set("disk_size_gb", props.DiskSizeGB)
@@ -580,3 +512,193 @@ func resourceManagedDiskDelete(d *schema.ResourceData, meta interface{}) error {
 
 	return nil
 }
+
+// updateManagedDisk updates the Managed Disk, shutting down (and deallocating) the Virtual Machine it's attached to
+// when required. When the disk is being resized without downtime but the subscription doesn't support this (since it
+// requires the `Microsoft.Compute/LiveResize` feature to be registered) the Virtual Machine is shut down and deallocated
+func updateManagedDisk(ctx context.Context, client *compute.DisksClient, vmClient *compute.VirtualMachinesClient, disk compute.Disk, resourceGroup, name string, diskUpdate compute.DiskUpdate, shouldShutDown, liveResize bool) error {
+	if shouldShutDown {
+		return updateManagedDiskWithShutDown(ctx, client, vmClient, disk, resourceGroup, name, diskUpdate)
+	}
+
+	// otherwise, just update it
+	diskFuture, err := client.Update(ctx, resourceGroup, name, diskUpdate)
+	if err == nil {
+		err = diskFuture.WaitForCompletionRef(ctx, client.Client)
+		if err == nil {
+			return nil
+		}
+
+		if !liveResize || !managedDiskLiveResizeNotAllowed(err) {
+			return fmt.Errorf("Error waiting for expand operation on managed disk %q (Resource Group %q): %+v", name, resourceGroup, err)
+		}
+	} else if !liveResize || !managedDiskLiveResizeNotAllowed(err) {
+		return fmt.Errorf("Error expanding managed disk %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	log.Printf("[DEBUG] Resizing Managed Disk %q (Resource Group %q) without downtime isn't allowed - shutting down the Virtual Machine: %+v", name, resourceGroup, err)
+	return updateManagedDiskWithShutDown(ctx, client, vmClient, disk, resourceGroup, name, diskUpdate)
+}
+
+// if we are attached to a VM we bring down the VM as necessary for the operations which are not allowed while it's online
+func updateManagedDiskWithShutDown(ctx context.Context, client *compute.DisksClient, vmClient *compute.VirtualMachinesClient, disk compute.Disk, resourceGroup, name string, diskUpdate compute.DiskUpdate) error {
+	virtualMachine, err := parse.VirtualMachineID(*disk.ManagedBy)
+	if err != nil {
+		return fmt.Errorf("Error parsing VMID %q for disk attachment: %+v", *disk.ManagedBy, err)
+	}
+
+	locks.ByName(name, virtualMachineResourceName)
+	defer locks.UnlockByName(name, virtualMachineResourceName)
+
+	instanceView, err := vmClient.InstanceView(ctx, virtualMachine.ResourceGroup, virtualMachine.Name)
+	if err != nil {
+		return fmt.Errorf("Error retrieving InstanceView for Virtual Machine %q (Resource Group %q): %+v", virtualMachine.Name, virtualMachine.ResourceGroup, err)
+	}
+
+	// check instanceView State
+	shouldShutDown := true
+	shouldTurnBackOn := true
+	shouldDeallocate := true
+
+	if instanceView.Statuses != nil {
+		for _, status := range *instanceView.Statuses {
+			if status.Code == nil {
+				continue
+			}
+
+			// could also be the provisioning state which we're not bothered with here
+			state := strings.ToLower(*status.Code)
+			if !strings.HasPrefix(state, "powerstate/") {
+				continue
+			}
+
+			state = strings.TrimPrefix(state, "powerstate/")
+			switch strings.ToLower(state) {
+			case "deallocated":
+			case "deallocating":
+				shouldTurnBackOn = false
+				shouldShutDown = false
+				shouldDeallocate = false
+			case "stopping":
+			case "stopped":
+				shouldShutDown = false
+				shouldTurnBackOn = false
+			}
+		}
+	}
+
+	// Shutdown
+	if shouldShutDown {
+		log.Printf("[DEBUG] Shutting Down Virtual Machine %q (Resource Group %q)..", virtualMachine.Name, virtualMachine.ResourceGroup)
+		forceShutdown := false
+		future, err := vmClient.PowerOff(ctx, virtualMachine.ResourceGroup, virtualMachine.Name, utils.Bool(forceShutdown))
+		if err != nil {
+			return fmt.Errorf("Error sending Power Off to Virtual Machine %q (Resource Group %q): %+v", virtualMachine.Name, virtualMachine.ResourceGroup, err)
+		}
+
+		if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
+			return fmt.Errorf("Error waiting for Power Off of Virtual Machine %q (Resource Group %q): %+v", virtualMachine.Name, virtualMachine.ResourceGroup, err)
+		}
+
+		log.Printf("[DEBUG] Shut Down Virtual Machine %q (Resource Group %q)..", virtualMachine.Name, virtualMachine.ResourceGroup)
+	}
+
+	// De-allocate
+	if shouldDeallocate {
+		log.Printf("[DEBUG] Deallocating Virtual Machine %q (Resource Group %q)..", virtualMachine.Name, virtualMachine.ResourceGroup)
+		deAllocFuture, err := vmClient.Deallocate(ctx, virtualMachine.ResourceGroup, virtualMachine.Name)
+		if err != nil {
+			return fmt.Errorf("Error Deallocating to Virtual Machine %q (Resource Group %q): %+v", virtualMachine.Name, virtualMachine.ResourceGroup, err)
+		}
+
+		if err := deAllocFuture.WaitForCompletionRef(ctx, client.Client); err != nil {
+			return fmt.Errorf("Error waiting for Deallocation of Virtual Machine %q (Resource Group %q): %+v", virtualMachine.Name, virtualMachine.ResourceGroup, err)
+		}
+
+		log.Printf("[DEBUG] Deallocated Virtual Machine %q (Resource Group %q)..", virtualMachine.Name, virtualMachine.ResourceGroup)
+	}
+
+	// Update Disk
+	updateFuture, err := client.Update(ctx, resourceGroup, name, diskUpdate)
+	if err != nil {
+		return fmt.Errorf("Error updating Managed Disk %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+	if err := updateFuture.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("Error waiting for update of Managed Disk %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	if shouldTurnBackOn {
+		log.Printf("[DEBUG] Starting Linux Virtual Machine %q (Resource Group %q)..", virtualMachine.Name, virtualMachine.ResourceGroup)
+		future, err := vmClient.Start(ctx, virtualMachine.ResourceGroup, virtualMachine.Name)
+		if err != nil {
+			return fmt.Errorf("Error starting Virtual Machine %q (Resource Group %q): %+v", virtualMachine.Name, virtualMachine.ResourceGroup, err)
+		}
+
+		if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
+			return fmt.Errorf("Error waiting for start of Virtual Machine %q (Resource Group %q): %+v", virtualMachine.Name, virtualMachine.ResourceGroup, err)
+		}
+
+		log.Printf("[DEBUG] Started Virtual Machine %q (Resource Group %q)..", virtualMachine.Name, virtualMachine.ResourceGroup)
+	}
+
+	return nil
+}
+
+// managedDiskLiveResizeNotAllowed returns whether the API rejected resizing an attached disk without deallocating
+// the Virtual Machine - which is the case for subscriptions without the `Microsoft.Compute/LiveResize` feature
+func managedDiskLiveResizeNotAllowed(err error) bool {
+	var serviceError *azureRest.ServiceError
+
+	var requestError azureRest.RequestError
+	var requestErrorPtr *azureRest.RequestError
+	switch {
+	case errors.As(err, &requestError):
+		serviceError = requestError.ServiceError
+	case errors.As(err, &requestErrorPtr):
+		serviceError = requestErrorPtr.ServiceError
+	default:
+		errors.As(err, &serviceError)
+	}
+
+	return serviceError != nil && strings.EqualFold(serviceError.Code, "OperationNotAllowed")
+}
+
+// managedDiskSupportsNoDowntimeResize determines whether the disk can be expanded without deallocating
+// the Virtual Machine it's attached to, which is only possible for data disks and not for Ultra or Shared disks
+// see: https://docs.microsoft.com/en-us/azure/virtual-machines/linux/expand-disks#expand-without-downtime
+func managedDiskSupportsNoDowntimeResize(disk compute.Disk, oldSizeGB, newSizeGB int) bool {
+	if disk.DiskProperties == nil || disk.Sku == nil {
+		return false
+	}
+
+	supportedStorageAccountTypes := []compute.DiskStorageAccountTypes{
+		compute.PremiumLRS,
+		compute.StandardLRS,
+		compute.StandardSSDLRS,
+	}
+	supported := false
+	for _, v := range supportedStorageAccountTypes {
+		if strings.EqualFold(string(disk.Sku.Name), string(v)) {
+			supported = true
+		}
+	}
+	if !supported {
+		return false
+	}
+
+	// only data disks can be expanded online
+	if disk.DiskProperties.OsType != "" {
+		return false
+	}
+
+	if disk.DiskProperties.MaxShares != nil && *disk.DiskProperties.MaxShares > 1 {
+		return false
+	}
+
+	// a disk of 4TiB or less can't be expanded beyond 4TiB without deallocating the Virtual Machine
+	if oldSizeGB <= 4096 && newSizeGB > 4096 {
+		return false
+	}
+
+	return true
+}
@@ -0,0 +1,242 @@
+package compute
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/Azure/azure-sdk-for-go/services/compute/mgmt/2020-12-01/compute"
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func TestManagedDiskSupportsNoDowntimeResize(t *testing.T) {
+	buildDisk := func(storageAccountType compute.DiskStorageAccountTypes, osType compute.OperatingSystemTypes, maxShares *int32) compute.Disk {
+		return compute.Disk{
+			Sku: &compute.DiskSku{
+				Name: storageAccountType,
+			},
+			DiskProperties: &compute.DiskProperties{
+				OsType:    osType,
+				MaxShares: maxShares,
+			},
+		}
+	}
+
+	testCases := []struct {
+		Name      string
+		Input     compute.Disk
+		OldSizeGB int
+		NewSizeGB int
+		Expected  bool
+	}{
+		{
+			Name:      "No Properties",
+			Input:     compute.Disk{},
+			OldSizeGB: 10,
+			NewSizeGB: 20,
+			Expected:  false,
+		},
+		{
+			Name:      "Premium Data Disk",
+			Input:     buildDisk(compute.PremiumLRS, "", nil),
+			OldSizeGB: 10,
+			NewSizeGB: 20,
+			Expected:  true,
+		},
+		{
+			Name:      "Standard SSD Data Disk",
+			Input:     buildDisk(compute.StandardSSDLRS, "", utils.Int32(1)),
+			OldSizeGB: 10,
+			NewSizeGB: 20,
+			Expected:  true,
+		},
+		{
+			Name:      "Ultra Data Disk",
+			Input:     buildDisk(compute.UltraSSDLRS, "", nil),
+			OldSizeGB: 10,
+			NewSizeGB: 20,
+			Expected:  false,
+		},
+		{
+			Name:      "OS Disk",
+			Input:     buildDisk(compute.PremiumLRS, compute.Linux, nil),
+			OldSizeGB: 30,
+			NewSizeGB: 64,
+			Expected:  false,
+		},
+		{
+			Name:      "Shared Disk",
+			Input:     buildDisk(compute.PremiumLRS, "", utils.Int32(2)),
+			OldSizeGB: 256,
+			NewSizeGB: 512,
+			Expected:  false,
+		},
+		{
+			Name:      "Expanding beyond 4TiB",
+			Input:     buildDisk(compute.PremiumLRS, "", nil),
+			OldSizeGB: 4096,
+			NewSizeGB: 8192,
+			Expected:  false,
+		},
+		{
+			Name:      "Expanding above 4TiB",
+			Input:     buildDisk(compute.PremiumLRS, "", nil),
+			OldSizeGB: 8192,
+			NewSizeGB: 16384,
+			Expected:  true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Logf("Running %q..", testCase.Name)
+
+		result := managedDiskSupportsNoDowntimeResize(testCase.Input, testCase.OldSizeGB, testCase.NewSizeGB)
+		if result != testCase.Expected {
+			t.Fatalf("Expected %t but got %t", testCase.Expected, result)
+		}
+	}
+}
+
+func TestUpdateManagedDiskLiveResizeFallback(t *testing.T) {
+	vmId := "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.Compute/virtualMachines/vm1"
+
+	testCases := []struct {
+		Name             string
+		ShouldShutDown   bool
+		LiveResize       bool
+		LiveResizeStatus int
+		LiveResizeError  string
+		ExpectedRequests []string
+		ExpectError      bool
+	}{
+		{
+			Name:             "Live Resize",
+			LiveResize:       true,
+			LiveResizeStatus: http.StatusOK,
+			ExpectedRequests: []string{
+				"PATCH disks/disk1",
+			},
+		},
+		{
+			Name:             "Live Resize not Allowed",
+			LiveResize:       true,
+			LiveResizeStatus: http.StatusConflict,
+			LiveResizeError:  "OperationNotAllowed",
+			ExpectedRequests: []string{
+				"PATCH disks/disk1",
+				"GET virtualMachines/vm1/instanceView",
+				"POST virtualMachines/vm1/powerOff",
+				"POST virtualMachines/vm1/deallocate",
+				"PATCH disks/disk1",
+				"POST virtualMachines/vm1/start",
+			},
+		},
+		{
+			Name:             "Live Resize fails with another Error",
+			LiveResize:       true,
+			LiveResizeStatus: http.StatusBadRequest,
+			LiveResizeError:  "InvalidParameter",
+			ExpectedRequests: []string{
+				"PATCH disks/disk1",
+			},
+			ExpectError: true,
+		},
+		{
+			// the fallback only applies when resizing without downtime
+			Name:             "Update not Allowed",
+			LiveResize:       false,
+			LiveResizeStatus: http.StatusConflict,
+			LiveResizeError:  "OperationNotAllowed",
+			ExpectedRequests: []string{
+				"PATCH disks/disk1",
+			},
+			ExpectError: true,
+		},
+		{
+			Name:             "Shut Down",
+			ShouldShutDown:   true,
+			LiveResizeStatus: http.StatusOK,
+			ExpectedRequests: []string{
+				"GET virtualMachines/vm1/instanceView",
+				"POST virtualMachines/vm1/powerOff",
+				"POST virtualMachines/vm1/deallocate",
+				"PATCH disks/disk1",
+				"POST virtualMachines/vm1/start",
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Logf("Running %q..", testCase.Name)
+
+		requests := make([]string, 0)
+		deallocated := false
+		sender := autorest.SenderFunc(func(r *http.Request) (*http.Response, error) {
+			path := r.URL.Path
+			if i := strings.Index(path, "/disks/"); i != -1 {
+				path = path[i+1:]
+			}
+			if i := strings.Index(path, "/virtualMachines/"); i != -1 {
+				path = path[i+1:]
+			}
+			requests = append(requests, fmt.Sprintf("%s %s", r.Method, path))
+
+			statusCode := http.StatusOK
+			body := `{"properties": {"provisioningState": "Succeeded"}}`
+			switch {
+			case strings.HasSuffix(path, "/instanceView"):
+				body = `{"statuses": [{"code": "PowerState/running"}]}`
+			case strings.HasSuffix(path, "/deallocate"):
+				deallocated = true
+				body = ""
+			case strings.HasPrefix(path, "virtualMachines/"):
+				body = ""
+			case !deallocated && testCase.LiveResizeStatus != http.StatusOK:
+				statusCode = testCase.LiveResizeStatus
+				body = fmt.Sprintf(`{"error": {"code": %q, "message": "hello-world"}}`, testCase.LiveResizeError)
+			}
+
+			return &http.Response{
+				StatusCode:    statusCode,
+				Status:        http.StatusText(statusCode),
+				Header:        http.Header{"Content-Type": []string{"application/json"}},
+				Body:          ioutil.NopCloser(strings.NewReader(body)),
+				ContentLength: int64(len(body)),
+				Request:       r,
+			}, nil
+		})
+
+		disksClient := compute.NewDisksClient("00000000-0000-0000-0000-000000000000")
+		disksClient.Sender = sender
+		disksClient.RetryAttempts = 1
+		vmClient := compute.NewVirtualMachinesClient("00000000-0000-0000-0000-000000000000")
+		vmClient.Sender = sender
+		vmClient.RetryAttempts = 1
+
+		disk := compute.Disk{
+			ManagedBy: utils.String(vmId),
+		}
+		diskUpdate := compute.DiskUpdate{
+			DiskUpdateProperties: &compute.DiskUpdateProperties{
+				DiskSizeGB: utils.Int32(64),
+			},
+		}
+
+		err := updateManagedDisk(context.TODO(), &disksClient, &vmClient, disk, "group1", "disk1", diskUpdate, testCase.ShouldShutDown, testCase.LiveResize)
+		if testCase.ExpectError && err == nil {
+			t.Fatalf("Expected an error but didn't get one")
+		}
+		if !testCase.ExpectError && err != nil {
+			t.Fatalf("Expected no error but got: %+v", err)
+		}
+
+		if !reflect.DeepEqual(testCase.ExpectedRequests, requests) {
+			t.Fatalf("Expected the requests %+v but got %+v", testCase.ExpectedRequests, requests)
+		}
+	}
+}
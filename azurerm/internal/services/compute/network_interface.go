@@ -2,6 +2,7 @@ package compute
 
 import (
 	"context"
+	"sync"
 
 	"github.com/Azure/azure-sdk-for-go/services/compute/mgmt/2020-12-01/compute"
 	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2020-05-01/network"
@@ -34,20 +35,32 @@ func retrieveConnectionInformation(ctx context.Context, nicsClient *network.Inte
 	privateIPAddresses := make([]string, 0)
 	publicIPAddresses := make([]string, 0)
 
-	if input != nil && input.NetworkProfile != nil && input.NetworkProfile.NetworkInterfaces != nil {
-		for _, v := range *input.NetworkProfile.NetworkInterfaces {
-			if v.ID == nil {
-				continue
-			}
+	// the Network Interfaces are retrieved in parallel since a Virtual Machine can have several attached, each of
+	// which can require further lookups - the results are then combined in the order they're defined on the VM
+	networkInterfaces := *input.NetworkProfile.NetworkInterfaces
+	nics := make([]*interfaceDetails, len(networkInterfaces))
 
-			nic := retrieveIPAddressesForNIC(ctx, nicsClient, pipsClient, *v.ID)
-			if nic == nil {
-				continue
-			}
+	wg := sync.WaitGroup{}
+	for i, v := range networkInterfaces {
+		if v.ID == nil {
+			continue
+		}
 
-			privateIPAddresses = append(privateIPAddresses, nic.privateIPAddresses...)
-			publicIPAddresses = append(publicIPAddresses, nic.publicIPAddresses...)
+		wg.Add(1)
+		go func(index int, nicID string) {
+			defer wg.Done()
+			nics[index] = retrieveIPAddressesForNIC(ctx, nicsClient, pipsClient, nicID)
+		}(i, *v.ID)
+	}
+	wg.Wait()
+
+	for _, nic := range nics {
+		if nic == nil {
+			continue
 		}
+
+		privateIPAddresses = append(privateIPAddresses, nic.privateIPAddresses...)
+		publicIPAddresses = append(publicIPAddresses, nic.publicIPAddresses...)
 	}
 
 	primaryPrivateAddress := ""
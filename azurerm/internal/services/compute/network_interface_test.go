@@ -0,0 +1,144 @@
+package compute
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/compute/mgmt/2020-12-01/compute"
+	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2020-05-01/network"
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func TestRetrieveConnectionInformation(t *testing.T) {
+	nicId := func(name string) string {
+		return fmt.Sprintf("/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.Network/networkInterfaces/%s", name)
+	}
+
+	cases := []struct {
+		Name                    string
+		NetworkInterfaces       []string
+		FailedNetworkInterfaces map[string]bool
+		Expected                connectionInfo
+	}{
+		{
+			Name:              "No Network Interfaces",
+			NetworkInterfaces: []string{},
+			Expected: connectionInfo{
+				privateAddresses: []string{},
+				publicAddresses:  []string{},
+			},
+		},
+		{
+			Name:              "Single Network Interface",
+			NetworkInterfaces: []string{"nic1"},
+			Expected: connectionInfo{
+				primaryPrivateAddress: "10.0.0.1",
+				privateAddresses:      []string{"10.0.0.1"},
+				primaryPublicAddress:  "1.1.1.1",
+				publicAddresses:       []string{"1.1.1.1"},
+			},
+		},
+		{
+			// the first Network Interface responds last, however the results must be in the order defined on the VM
+			Name:              "Multiple Network Interfaces",
+			NetworkInterfaces: []string{"nic1", "nic2", "nic3"},
+			Expected: connectionInfo{
+				primaryPrivateAddress: "10.0.0.1",
+				privateAddresses:      []string{"10.0.0.1", "10.0.0.2", "10.0.0.3"},
+				primaryPublicAddress:  "1.1.1.1",
+				publicAddresses:       []string{"1.1.1.1", "1.1.1.2", "1.1.1.3"},
+			},
+		},
+		{
+			// a Network Interface which can't be retrieved is skipped, rather than failing the whole lookup
+			Name:                    "First Network Interface Fails",
+			NetworkInterfaces:       []string{"nic1", "nic2", "nic3"},
+			FailedNetworkInterfaces: map[string]bool{"nic1": true},
+			Expected: connectionInfo{
+				primaryPrivateAddress: "10.0.0.2",
+				privateAddresses:      []string{"10.0.0.2", "10.0.0.3"},
+				primaryPublicAddress:  "1.1.1.2",
+				publicAddresses:       []string{"1.1.1.2", "1.1.1.3"},
+			},
+		},
+		{
+			Name:                    "All Network Interfaces Fail",
+			NetworkInterfaces:       []string{"nic1", "nic2"},
+			FailedNetworkInterfaces: map[string]bool{"nic1": true, "nic2": true},
+			Expected: connectionInfo{
+				privateAddresses: []string{},
+				publicAddresses:  []string{},
+			},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Logf("[DEBUG] Testing %q..", tc.Name)
+
+		sender := autorest.SenderFunc(func(r *http.Request) (*http.Response, error) {
+			segments := strings.Split(r.URL.Path, "/")
+			resourceType := segments[len(segments)-2]
+			name := segments[len(segments)-1]
+			index := strings.TrimPrefix(strings.TrimPrefix(name, "nic"), "pip")
+
+			statusCode := http.StatusOK
+			body := ""
+			switch resourceType {
+			case "networkInterfaces":
+				// the Network Interfaces respond in the reverse order to which they're defined
+				if index == "1" {
+					time.Sleep(50 * time.Millisecond)
+				}
+
+				pipId := fmt.Sprintf("/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.Network/publicIPAddresses/pip%s", index)
+				body = fmt.Sprintf(`{"properties": {"ipConfigurations": [{"properties": {"privateIPAddress": "10.0.0.%s", "publicIPAddress": {"id": %q}}}]}}`, index, pipId)
+				if tc.FailedNetworkInterfaces[name] {
+					statusCode = http.StatusNotFound
+					body = `{"error": {"code": "NotFound", "message": "hello-world"}}`
+				}
+
+			case "publicIPAddresses":
+				body = fmt.Sprintf(`{"properties": {"ipAddress": "1.1.1.%s"}}`, index)
+			}
+
+			return &http.Response{
+				StatusCode: statusCode,
+				Status:     http.StatusText(statusCode),
+				Header:     http.Header{"Content-Type": []string{"application/json"}},
+				Body:       ioutil.NopCloser(strings.NewReader(body)),
+				Request:    r,
+			}, nil
+		})
+
+		nicsClient := network.NewInterfacesClient("00000000-0000-0000-0000-000000000000")
+		nicsClient.Sender = sender
+		nicsClient.RetryAttempts = 1
+		pipsClient := network.NewPublicIPAddressesClient("00000000-0000-0000-0000-000000000000")
+		pipsClient.Sender = sender
+		pipsClient.RetryAttempts = 1
+
+		networkInterfaces := make([]compute.NetworkInterfaceReference, 0)
+		for _, name := range tc.NetworkInterfaces {
+			networkInterfaces = append(networkInterfaces, compute.NetworkInterfaceReference{
+				ID: utils.String(nicId(name)),
+			})
+		}
+		input := &compute.VirtualMachineProperties{
+			NetworkProfile: &compute.NetworkProfile{
+				NetworkInterfaces: &networkInterfaces,
+			},
+		}
+
+		actual := retrieveConnectionInformation(context.TODO(), &nicsClient, &pipsClient, input)
+		if !reflect.DeepEqual(tc.Expected, actual) {
+			t.Fatalf("expected %+v but got %+v", tc.Expected, actual)
+		}
+	}
+}
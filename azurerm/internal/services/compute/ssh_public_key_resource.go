@@ -56,9 +56,26 @@ func resourceSshPublicKey() *schema.Resource {
 
 			"public_key": {
 				Type:         schema.TypeString,
-				Required:     true,
+				Optional:     true,
+				Computed:     true,
 				ForceNew:     false,
 				ValidateFunc: ValidateSSHKey,
+				ExactlyOneOf: []string{"public_key", "generate"},
+			},
+
+			// `generate` is only used at creation time and can't be read back from the API, so this isn't ForceNew
+			// to allow an imported (or previously generated) SSH Public Key to be managed without being recreated
+			"generate": {
+				Type:         schema.TypeBool,
+				Optional:     true,
+				ValidateFunc: validateSshPublicKeyGenerate,
+				ExactlyOneOf: []string{"public_key", "generate"},
+			},
+
+			"private_key": {
+				Type:      schema.TypeString,
+				Computed:  true,
+				Sensitive: true,
 			},
 
 			"tags": tags.Schema(),
@@ -74,6 +91,7 @@ func resourceSshPublicKeyCreate(d *schema.ResourceData, meta interface{}) error
 	name := d.Get("name").(string)
 	resourceGroup := d.Get("resource_group_name").(string)
 	public_key := d.Get("public_key").(string)
+	generate := d.Get("generate").(bool)
 
 	resp, err := client.Get(ctx, resourceGroup, name)
 	if err != nil {
@@ -91,12 +109,15 @@ func resourceSshPublicKeyCreate(d *schema.ResourceData, meta interface{}) error
 	t := d.Get("tags").(map[string]interface{})
 
 	params := compute.SSHPublicKeyResource{
-		Name:     utils.String(name),
-		Location: utils.String(location),
-		Tags:     tags.Expand(t),
-		SSHPublicKeyResourceProperties: &compute.SSHPublicKeyResourceProperties{
-			PublicKey: utils.String(public_key),
-		},
+		Name:                           utils.String(name),
+		Location:                       utils.String(location),
+		Tags:                           tags.Expand(t),
+		SSHPublicKeyResourceProperties: &compute.SSHPublicKeyResourceProperties{},
+	}
+
+	// when generating a Key Pair the resource is created empty and the Public Key is populated by the API
+	if !generate {
+		params.SSHPublicKeyResourceProperties.PublicKey = utils.String(public_key)
 	}
 
 	if _, err := client.Create(ctx, resourceGroup, name, params); err != nil {
@@ -112,7 +133,19 @@ func resourceSshPublicKeyCreate(d *schema.ResourceData, meta interface{}) error
 		return fmt.Errorf("retrieving SSH Public Key %q (Resource Group %q): `id` was nil", name, resourceGroup)
 	}
 
+	// the ID is set prior to generating the Key Pair so that, should that fail, the (tainted) resource is tracked
 	d.SetId(*read.ID)
+
+	if generate {
+		keyPair, err := client.GenerateKeyPair(ctx, resourceGroup, name)
+		if err != nil {
+			return fmt.Errorf("generating Key Pair for SSH Public Key %q (Resource Group %q): %+v", name, resourceGroup, err)
+		}
+
+		// the Private Key is only returned at generation time, so this has to be persisted here
+		d.Set("private_key", keyPair.PrivateKey)
+	}
+
 	return resourceSshPublicKeyRead(d, meta)
 }
 
@@ -148,6 +181,8 @@ func resourceSshPublicKeyRead(d *schema.ResourceData, meta interface{}) error {
 		d.Set("public_key", props.PublicKey)
 	}
 
+	// `generate` and `private_key` can't be determined from the API, so these are left as-is (and are empty on import)
+
 	return tags.FlattenAndSet(d, resp.Tags)
 }
 
@@ -186,6 +221,12 @@ func resourceSshPublicKeyUpdate(d *schema.ResourceData, meta interface{}) error
 		update.Tags = tags.Expand(tagsRaw)
 	}
 
+	// a new Key Pair isn't generated when `generate` changes - however the Private Key no longer matches a
+	// Public Key which is specified explicitly, so it's removed from the state
+	if d.HasChange("generate") && !d.Get("generate").(bool) {
+		d.Set("private_key", "")
+	}
+
 	log.Printf("[DEBUG] Updating SSH Public Key %q (Resource Group %q)..", id.Name, id.ResourceGroup)
 
 	if _, err := client.Update(ctx, id.ResourceGroup, id.Name, update); err != nil {
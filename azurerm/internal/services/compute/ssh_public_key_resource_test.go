@@ -44,6 +44,24 @@ func TestAccSshPublicKey_CreateUpdate(t *testing.T) {
 	})
 }
 
+func TestAccSshPublicKey_generate(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_ssh_public_key", "test")
+	r := SSHPublicKeyResource{}
+
+	data.ResourceTest(t, r, []resource.TestStep{
+		{
+			Config: r.generate(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("public_key").Exists(),
+				check.That(data.ResourceName).Key("private_key").Exists(),
+			),
+		},
+		// the Private Key can't be retrieved from the API, as such neither it nor `generate` are set on import
+		data.ImportStep("generate", "private_key"),
+	})
+}
+
 func (t SSHPublicKeyResource) Exists(ctx context.Context, clients *clients.Client, state *terraform.InstanceState) (*bool, error) {
 	id, err := parse.SSHPublicKeyID(state.ID)
 	if err != nil {
@@ -81,3 +99,23 @@ resource "azurerm_ssh_public_key" "test" {
 }
 `, data.RandomInteger, data.Locations.Primary, data.RandomInteger, sshKey, data.RandomInteger)
 }
+
+func (SSHPublicKeyResource) generate(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "ACCTESTRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_ssh_public_key" "test" {
+  name                = "test-public-key-%d"
+  resource_group_name = azurerm_resource_group.test.name
+  location            = azurerm_resource_group.test.location
+  generate            = true
+}
+`, data.RandomInteger, data.Locations.Primary, data.RandomInteger)
+}
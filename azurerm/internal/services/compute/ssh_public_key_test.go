@@ -0,0 +1,70 @@
+package compute
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+)
+
+func TestSshPublicKeyGenerateOrPublicKey(t *testing.T) {
+	publicKey := "ssh-rsa AAAAB3NzaC1yc2EAAAADAQABAAABAQC+wWK73dCr+jgQOAxNsHAnNNNMEMWOHYEccp6wJm2gotpr9katuF/ZAdou5AaW1C61slRkHRkpRRX9FA9CYBiitZgvCCz+3nWNN7l/Up54Zps/pHWGZLHNJZRYyAB6j5yVLMVHIHriY49d/GZTZVNB8GoJv9Gakwc/fuEZYYl4YDFiGMBP///TzlI4jhiJzjKnEvqPFki5p2ZRJqcbCiF4pJrxUQR/RXqVFQdbRLZgYfJ8xGB878RENq3yQ39d8dVOkq4edbkzwcUmwwwkYVPIoDGsYLaRHnG+To7FvMeyO7xDVQkMKzopTQV8AuKpyvpqu0a9pWOMaiCyDytO7GGN you@me.com"
+
+	cases := []struct {
+		Name   string
+		Config map[string]interface{}
+		Error  bool
+	}{
+		{
+			Name:   "Neither",
+			Config: map[string]interface{}{},
+			Error:  true,
+		},
+		{
+			Name: "Public Key",
+			Config: map[string]interface{}{
+				"public_key": publicKey,
+			},
+			Error: false,
+		},
+		{
+			Name: "Generate",
+			Config: map[string]interface{}{
+				"generate": true,
+			},
+			Error: false,
+		},
+		{
+			Name: "Generate Disabled",
+			Config: map[string]interface{}{
+				"generate": false,
+			},
+			Error: true,
+		},
+		{
+			Name: "Both",
+			Config: map[string]interface{}{
+				"public_key": publicKey,
+				"generate":   true,
+			},
+			Error: true,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Logf("[DEBUG] Testing %q..", tc.Name)
+
+		raw := map[string]interface{}{
+			"name":                "example",
+			"resource_group_name": "example",
+			"location":            "westeurope",
+		}
+		for k, v := range tc.Config {
+			raw[k] = v
+		}
+
+		_, errors := resourceSshPublicKey().Validate(terraform.NewResourceConfigRaw(raw))
+		if tc.Error != (len(errors) > 0) {
+			t.Fatalf("expected an error to be %t but got: %+v", tc.Error, errors)
+		}
+	}
+}
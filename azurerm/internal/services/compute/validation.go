@@ -186,3 +186,17 @@ func validateDedicatedHostGroupName() func(i interface{}, k string) (warnings []
 func validateDedicatedHostName() func(i interface{}, k string) (warnings []string, errors []error) {
 	return validateDedicatedHostGroupName()
 }
+
+func validateSshPublicKeyGenerate(i interface{}, k string) (warnings []string, errors []error) {
+	v, ok := i.(bool)
+	if !ok {
+		return nil, []error{fmt.Errorf("expected type of %q to be bool", k)}
+	}
+
+	// omitting `generate` is equivalent to setting it to `false`, in which case a `public_key` must be specified
+	if !v {
+		return nil, []error{fmt.Errorf("%q can only be set to `true`, otherwise `public_key` must be specified", k)}
+	}
+
+	return nil, nil
+}
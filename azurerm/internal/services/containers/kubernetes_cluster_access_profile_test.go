@@ -0,0 +1,142 @@
+package containers
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/containerservice/mgmt/2020-12-01/containerservice"
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/containers/parse"
+)
+
+func TestRetrieveKubernetesClusterAccessProfiles(t *testing.T) {
+	cases := []struct {
+		Name          string
+		IncludeAdmin  bool
+		FailedRoles   map[string]bool
+		ExpectedError string
+	}{
+		{
+			Name:         "User Only",
+			IncludeAdmin: false,
+		},
+		{
+			Name:         "User and Admin",
+			IncludeAdmin: true,
+		},
+		{
+			Name:          "User Fails",
+			IncludeAdmin:  true,
+			FailedRoles:   map[string]bool{"clusterUser": true},
+			ExpectedError: "retrieving Access Profile",
+		},
+		{
+			Name:          "Admin Fails",
+			IncludeAdmin:  true,
+			FailedRoles:   map[string]bool{"clusterAdmin": true},
+			ExpectedError: "retrieving Admin Access Profile",
+		},
+		{
+			// the User Access Profile is checked first, regardless of which request fails first
+			Name:          "Both Fail",
+			IncludeAdmin:  true,
+			FailedRoles:   map[string]bool{"clusterUser": true, "clusterAdmin": true},
+			ExpectedError: "retrieving Access Profile",
+		},
+		{
+			// the Admin Access Profile isn't requested, so its failure is ignored
+			Name:         "Admin Fails but not Included",
+			IncludeAdmin: false,
+			FailedRoles:  map[string]bool{"clusterAdmin": true},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Logf("[DEBUG] Testing %q..", tc.Name)
+
+		requestedRoles := make(chan string, 2)
+		client := containerservice.NewManagedClustersClient("00000000-0000-0000-0000-000000000000")
+		client.RetryAttempts = 1
+		client.Sender = autorest.SenderFunc(func(r *http.Request) (*http.Response, error) {
+			segments := strings.Split(r.URL.Path, "/")
+			role := segments[len(segments)-2]
+			requestedRoles <- role
+
+			// the User Access Profile is returned last to ensure the results aren't assigned in the order they complete
+			if role == "clusterUser" {
+				time.Sleep(50 * time.Millisecond)
+			}
+
+			statusCode := http.StatusOK
+			body := fmt.Sprintf(`{"properties": {"kubeConfig": %q}}`, base64.StdEncoding.EncodeToString([]byte(role)))
+			if tc.FailedRoles[role] {
+				statusCode = http.StatusBadRequest
+				body = `{"error": {"code": "BadRequest", "message": "hello-world"}}`
+			}
+
+			return &http.Response{
+				StatusCode: statusCode,
+				Status:     http.StatusText(statusCode),
+				Header:     http.Header{"Content-Type": []string{"application/json"}},
+				Body:       ioutil.NopCloser(strings.NewReader(body)),
+				Request:    r,
+			}, nil
+		})
+
+		id := parse.NewClusterID("00000000-0000-0000-0000-000000000000", "group1", "cluster1")
+		userProfile, adminProfile, err := retrieveKubernetesClusterAccessProfiles(context.TODO(), &client, id, tc.IncludeAdmin)
+		close(requestedRoles)
+
+		expectedRequests := 1
+		if tc.IncludeAdmin {
+			expectedRequests = 2
+		}
+		if len(requestedRoles) != expectedRequests {
+			t.Fatalf("expected %d Access Profiles to be requested but got %d", expectedRequests, len(requestedRoles))
+		}
+
+		if tc.ExpectedError != "" {
+			if err == nil {
+				t.Fatalf("expected an error containing %q but didn't get one", tc.ExpectedError)
+			}
+			if !strings.HasPrefix(err.Error(), tc.ExpectedError) {
+				t.Fatalf("expected an error starting with %q but got: %+v", tc.ExpectedError, err)
+			}
+			if userProfile != nil || adminProfile != nil {
+				t.Fatalf("expected no Access Profiles to be returned when an error occurs")
+			}
+			continue
+		}
+
+		if err != nil {
+			t.Fatalf("expected no error but got: %+v", err)
+		}
+
+		if userProfile == nil || userProfile.AccessProfile == nil || userProfile.KubeConfig == nil {
+			t.Fatalf("expected the User Access Profile to be returned")
+		}
+		if v := string(*userProfile.KubeConfig); v != "clusterUser" {
+			t.Fatalf("expected the User Access Profile to be for %q but got %q", "clusterUser", v)
+		}
+
+		if !tc.IncludeAdmin {
+			if adminProfile != nil {
+				t.Fatalf("expected no Admin Access Profile to be returned")
+			}
+			continue
+		}
+
+		if adminProfile == nil || adminProfile.AccessProfile == nil || adminProfile.KubeConfig == nil {
+			t.Fatalf("expected the Admin Access Profile to be returned")
+		}
+		if v := string(*adminProfile.KubeConfig); v != "clusterAdmin" {
+			t.Fatalf("expected the Admin Access Profile to be for %q but got %q", "clusterAdmin", v)
+		}
+	}
+}
@@ -1,10 +1,12 @@
 package containers
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"strconv"
 	"strings"
+	"sync"
 	"time"
 
 	privateDnsValidate "github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/privatedns/validate"
@@ -1251,9 +1253,11 @@ func resourceKubernetesClusterRead(d *schema.ResourceData, meta interface{}) err
 		return fmt.Errorf("retrieving Managed Kubernetes Cluster %q (Resource Group %q): %+v", id.ManagedClusterName, id.ResourceGroup, err)
 	}
 
-	profile, err := client.GetAccessProfile(ctx, id.ResourceGroup, id.ManagedClusterName, "clusterUser")
+	// adminProfile is only available for RBAC enabled clusters with AAD
+	includeAdminProfile := resp.ManagedClusterProperties != nil && resp.ManagedClusterProperties.AadProfile != nil
+	profile, adminProfile, err := retrieveKubernetesClusterAccessProfiles(ctx, client, *id, includeAdminProfile)
 	if err != nil {
-		return fmt.Errorf("retrieving Access Profile for Managed Kubernetes Cluster %q (Resource Group %q): %+v", id.ManagedClusterName, id.ResourceGroup, err)
+		return err
 	}
 
 	d.Set("name", resp.Name)
@@ -1346,14 +1350,8 @@ func resourceKubernetesClusterRead(d *schema.ResourceData, meta interface{}) err
 			return fmt.Errorf("setting `windows_profile`: %+v", err)
 		}
 
-		// adminProfile is only available for RBAC enabled clusters with AAD
-		if props.AadProfile != nil {
-			adminProfile, err := client.GetAccessProfile(ctx, id.ResourceGroup, id.ManagedClusterName, "clusterAdmin")
-			if err != nil {
-				return fmt.Errorf("retrieving Admin Access Profile for Managed Kubernetes Cluster %q (Resource Group %q): %+v", id.ManagedClusterName, id.ResourceGroup, err)
-			}
-
-			adminKubeConfigRaw, adminKubeConfig := flattenKubernetesClusterAccessProfile(adminProfile)
+		if adminProfile != nil {
+			adminKubeConfigRaw, adminKubeConfig := flattenKubernetesClusterAccessProfile(*adminProfile)
 			d.Set("kube_admin_config_raw", adminKubeConfigRaw)
 			if err := d.Set("kube_admin_config", adminKubeConfig); err != nil {
 				return fmt.Errorf("setting `kube_admin_config`: %+v", err)
@@ -1373,7 +1371,7 @@ func resourceKubernetesClusterRead(d *schema.ResourceData, meta interface{}) err
 		return fmt.Errorf("setting `identity`: %+v", err)
 	}
 
-	kubeConfigRaw, kubeConfig := flattenKubernetesClusterAccessProfile(profile)
+	kubeConfigRaw, kubeConfig := flattenKubernetesClusterAccessProfile(*profile)
 	d.Set("kube_config_raw", kubeConfigRaw)
 	if err := d.Set("kube_config", kubeConfig); err != nil {
 		return fmt.Errorf("setting `kube_config`: %+v", err)
@@ -1382,6 +1380,45 @@ func resourceKubernetesClusterRead(d *schema.ResourceData, meta interface{}) err
 	return tags.FlattenAndSet(d, resp.Tags)
 }
 
+// retrieveKubernetesClusterAccessProfiles retrieves the User (and optionally the Admin) Access Profile for the
+// Managed Kubernetes Cluster - since these are independent of one another they're retrieved in parallel to
+// reduce the time taken to refresh large numbers of clusters
+func retrieveKubernetesClusterAccessProfiles(ctx context.Context, client *containerservice.ManagedClustersClient, id parse.ClusterId, includeAdmin bool) (*containerservice.ManagedClusterAccessProfile, *containerservice.ManagedClusterAccessProfile, error) {
+	var userProfile, adminProfile containerservice.ManagedClusterAccessProfile
+	var userErr, adminErr error
+
+	wg := sync.WaitGroup{}
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		userProfile, userErr = client.GetAccessProfile(ctx, id.ResourceGroup, id.ManagedClusterName, "clusterUser")
+	}()
+
+	if includeAdmin {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			adminProfile, adminErr = client.GetAccessProfile(ctx, id.ResourceGroup, id.ManagedClusterName, "clusterAdmin")
+		}()
+	}
+
+	wg.Wait()
+
+	if userErr != nil {
+		return nil, nil, fmt.Errorf("retrieving Access Profile for Managed Kubernetes Cluster %q (Resource Group %q): %+v", id.ManagedClusterName, id.ResourceGroup, userErr)
+	}
+
+	if !includeAdmin {
+		return &userProfile, nil, nil
+	}
+
+	if adminErr != nil {
+		return nil, nil, fmt.Errorf("retrieving Admin Access Profile for Managed Kubernetes Cluster %q (Resource Group %q): %+v", id.ManagedClusterName, id.ResourceGroup, adminErr)
+	}
+
+	return &userProfile, &adminProfile, nil
+}
+
 func resourceKubernetesClusterDelete(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*clients.Client).Containers.KubernetesClustersClient
 	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
@@ -6,10 +6,11 @@ import (
 )
 
 type Client struct {
-	AccountClient         *datashare.AccountsClient
-	DataSetClient         *datashare.DataSetsClient
-	SharesClient          *datashare.SharesClient
-	SynchronizationClient *datashare.SynchronizationSettingsClient
+	AccountClient           *datashare.AccountsClient
+	DataSetClient           *datashare.DataSetsClient
+	SharesClient            *datashare.SharesClient
+	ShareSubscriptionClient *datashare.ShareSubscriptionsClient
+	SynchronizationClient   *datashare.SynchronizationSettingsClient
 }
 
 func NewClient(o *common.ClientOptions) *Client {
@@ -22,13 +23,17 @@ func NewClient(o *common.ClientOptions) *Client {
 	sharesClient := datashare.NewSharesClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
 	o.ConfigureClient(&sharesClient.Client, o.ResourceManagerAuthorizer)
 
+	shareSubscriptionClient := datashare.NewShareSubscriptionsClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
+	o.ConfigureClient(&shareSubscriptionClient.Client, o.ResourceManagerAuthorizer)
+
 	synchronizationSettingsClient := datashare.NewSynchronizationSettingsClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
 	o.ConfigureClient(&synchronizationSettingsClient.Client, o.ResourceManagerAuthorizer)
 
 	return &Client{
-		AccountClient:         &accountClient,
-		DataSetClient:         &dataSetClient,
-		SharesClient:          &sharesClient,
-		SynchronizationClient: &synchronizationSettingsClient,
+		AccountClient:           &accountClient,
+		DataSetClient:           &dataSetClient,
+		SharesClient:            &sharesClient,
+		ShareSubscriptionClient: &shareSubscriptionClient,
+		SynchronizationClient:   &synchronizationSettingsClient,
 	}
 }
@@ -0,0 +1,202 @@
+package datashare
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/datashare/mgmt/2019-11-01/datashare"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/clients"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/location"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/datashare/parse"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/datashare/validate"
+	azSchema "github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/tf/schema"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/timeouts"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func resourceDataShareSubscription() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceDataShareSubscriptionCreate,
+		Read:   resourceDataShareSubscriptionRead,
+		Delete: resourceDataShareSubscriptionDelete,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+
+		Importer: azSchema.ValidateResourceIDPriorToImport(func(id string) error {
+			_, err := parse.ShareSubscriptionID(id)
+			return err
+		}),
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.ShareName(),
+			},
+
+			"account_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.AccountID,
+			},
+
+			"invitation_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IsUUID,
+			},
+
+			"source_share_location": azure.SchemaLocation(),
+
+			"share_name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"share_kind": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"share_description": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"share_terms": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"provider_email": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"provider_name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"provider_tenant_name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceDataShareSubscriptionCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).DataShare.ShareSubscriptionClient
+	ctx, cancel := timeouts.ForCreate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	accountId, err := parse.AccountID(d.Get("account_id").(string))
+	if err != nil {
+		return err
+	}
+
+	id := parse.NewShareSubscriptionID(accountId.SubscriptionId, accountId.ResourceGroup, accountId.Name, d.Get("name").(string))
+	existing, err := client.Get(ctx, id.ResourceGroup, id.AccountName, id.Name)
+	if err != nil {
+		if !utils.ResponseWasNotFound(existing.Response) {
+			return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+		}
+	}
+	if !utils.ResponseWasNotFound(existing.Response) {
+		return tf.ImportAsExistsError("azurerm_data_share_subscription", id.ID())
+	}
+
+	// creating the Share Subscription accepts the Invitation on behalf of the consumer
+	shareSubscription := datashare.ShareSubscription{
+		ShareSubscriptionProperties: &datashare.ShareSubscriptionProperties{
+			InvitationID:        utils.String(d.Get("invitation_id").(string)),
+			SourceShareLocation: utils.String(location.Normalize(d.Get("source_share_location").(string))),
+		},
+	}
+
+	if _, err := client.Create(ctx, id.ResourceGroup, id.AccountName, id.Name, shareSubscription); err != nil {
+		return fmt.Errorf("creating %s: %+v", id, err)
+	}
+
+	d.SetId(id.ID())
+	return resourceDataShareSubscriptionRead(d, meta)
+}
+
+func resourceDataShareSubscriptionRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).DataShare.ShareSubscriptionClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.ShareSubscriptionID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.Get(ctx, id.ResourceGroup, id.AccountName, id.Name)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			log.Printf("[INFO] %s does not exist - removing from state", *id)
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	d.Set("name", id.Name)
+	d.Set("account_id", parse.NewAccountID(id.SubscriptionId, id.ResourceGroup, id.AccountName).ID())
+
+	if props := resp.ShareSubscriptionProperties; props != nil {
+		d.Set("invitation_id", props.InvitationID)
+		d.Set("source_share_location", location.NormalizeNilable(props.SourceShareLocation))
+		d.Set("share_name", props.ShareName)
+		d.Set("share_kind", string(props.ShareKind))
+		d.Set("share_description", props.ShareDescription)
+		d.Set("share_terms", props.ShareTerms)
+		d.Set("provider_email", props.ProviderEmail)
+		d.Set("provider_name", props.ProviderName)
+		d.Set("provider_tenant_name", props.ProviderTenantName)
+		d.Set("status", string(props.ShareSubscriptionStatus))
+	}
+
+	return nil
+}
+
+func resourceDataShareSubscriptionDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).DataShare.ShareSubscriptionClient
+	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.ShareSubscriptionID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	future, err := client.Delete(ctx, id.ResourceGroup, id.AccountName, id.Name)
+	if err != nil {
+		return fmt.Errorf("deleting %s: %+v", *id, err)
+	}
+
+	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("waiting for deletion of %s: %+v", *id, err)
+	}
+
+	return nil
+}
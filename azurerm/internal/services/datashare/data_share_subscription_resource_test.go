@@ -0,0 +1,84 @@
+package datashare_test
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/acceptance"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/acceptance/check"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/clients"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/datashare/parse"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+type DataShareSubscriptionResource struct {
+}
+
+func TestAccDataShareSubscription_basic(t *testing.T) {
+	if os.Getenv("ARM_TEST_DATA_SHARE_INVITATION_ID") == "" {
+		t.Skip("Skipping as ARM_TEST_DATA_SHARE_INVITATION_ID is not specified")
+		return
+	}
+
+	data := acceptance.BuildTestData(t, "azurerm_data_share_subscription", "test")
+	r := DataShareSubscriptionResource{}
+
+	data.ResourceTest(t, r, []resource.TestStep{
+		{
+			Config: r.basic(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("share_name").Exists(),
+				check.That(data.ResourceName).Key("status").HasValue("Active"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (t DataShareSubscriptionResource) Exists(ctx context.Context, clients *clients.Client, state *terraform.InstanceState) (*bool, error) {
+	id, err := parse.ShareSubscriptionID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := clients.DataShare.ShareSubscriptionClient.Get(ctx, id.ResourceGroup, id.AccountName, id.Name)
+	if err != nil {
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	return utils.Bool(resp.ShareSubscriptionProperties != nil), nil
+}
+
+func (DataShareSubscriptionResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-datashare-%d"
+  location = "%s"
+}
+
+resource "azurerm_data_share_account" "test" {
+  name                = "acctest-dsa-%d"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+  identity {
+    type = "SystemAssigned"
+  }
+}
+
+resource "azurerm_data_share_subscription" "test" {
+  name                  = "acctest_dss_%d"
+  account_id            = azurerm_data_share_account.test.id
+  invitation_id         = "%s"
+  source_share_location = azurerm_resource_group.test.location
+}
+`, data.RandomInteger, data.Locations.Primary, data.RandomInteger, data.RandomInteger, os.Getenv("ARM_TEST_DATA_SHARE_INVITATION_ID"))
+}
@@ -39,5 +39,6 @@ func (r Registration) SupportedResources() map[string]*schema.Resource {
 		"azurerm_data_share_dataset_data_lake_gen2": resourceDataShareDataSetDataLakeGen2(),
 		"azurerm_data_share_dataset_kusto_cluster":  resourceDataShareDataSetKustoCluster(),
 		"azurerm_data_share_dataset_kusto_database": resourceDataShareDataSetKustoDatabase(),
+		"azurerm_data_share_subscription":           resourceDataShareSubscription(),
 	}
 }
@@ -0,0 +1,222 @@
+package keyvault
+
+import (
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/pem"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/keyvault/2016-10-01/keyvault"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/clients"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/keyvault/parse"
+	keyVaultValidate "github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/keyvault/validate"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/timeouts"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func resourceKeyVaultCertificateMerge() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceKeyVaultCertificateMergeCreate,
+		Read:   resourceKeyVaultCertificateMergeRead,
+		Delete: resourceKeyVaultCertificateMergeDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"key_vault_certificate_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: keyVaultValidate.NestedItemIdWithOptionalVersion,
+			},
+
+			"signed_certificate": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: keyVaultValidate.CertificatePEM,
+			},
+
+			"version": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"secret_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"certificate_data": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"thumbprint": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceKeyVaultCertificateMergeCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).KeyVault.ManagementClient
+	ctx, cancel := timeouts.ForCreate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	certificateId, err := parse.ParseOptionallyVersionedNestedItemID(d.Get("key_vault_certificate_id").(string))
+	if err != nil {
+		return err
+	}
+	if !strings.EqualFold(certificateId.NestedItemType, "certificates") {
+		return fmt.Errorf("`key_vault_certificate_id` must be the ID of a Key Vault Certificate but got a %q", certificateId.NestedItemType)
+	}
+
+	// the signed certificate can only be merged into a Certificate which is waiting for it
+	operation, err := client.GetCertificateOperation(ctx, certificateId.KeyVaultBaseUrl, certificateId.Name)
+	if err != nil {
+		if utils.ResponseWasNotFound(operation.Response) {
+			return fmt.Errorf("Certificate %q (Key Vault %q) has no pending Operation to merge the signed Certificate into", certificateId.Name, certificateId.KeyVaultBaseUrl)
+		}
+
+		return fmt.Errorf("retrieving the pending Operation for Certificate %q (Key Vault %q): %+v", certificateId.Name, certificateId.KeyVaultBaseUrl, err)
+	}
+	if operation.Status == nil || !strings.EqualFold(*operation.Status, "inProgress") {
+		status := ""
+		if operation.Status != nil {
+			status = *operation.Status
+		}
+		return fmt.Errorf("the Operation for Certificate %q (Key Vault %q) must be `inProgress` to merge the signed Certificate but was %q", certificateId.Name, certificateId.KeyVaultBaseUrl, status)
+	}
+
+	x509Certificates := expandKeyVaultCertificateMergeX509Certificates(d.Get("signed_certificate").(string))
+	parameters := keyvault.CertificateMergeParameters{
+		X509Certificates: &x509Certificates,
+	}
+	resp, err := client.MergeCertificate(ctx, certificateId.KeyVaultBaseUrl, certificateId.Name, parameters)
+	if err != nil {
+		return fmt.Errorf("merging the signed Certificate into Certificate %q (Key Vault %q): %+v", certificateId.Name, certificateId.KeyVaultBaseUrl, err)
+	}
+
+	if resp.ID == nil || *resp.ID == "" {
+		return fmt.Errorf("merging the signed Certificate into Certificate %q (Key Vault %q): `id` was nil", certificateId.Name, certificateId.KeyVaultBaseUrl)
+	}
+
+	d.SetId(*resp.ID)
+
+	return resourceKeyVaultCertificateMergeRead(d, meta)
+}
+
+func resourceKeyVaultCertificateMergeRead(d *schema.ResourceData, meta interface{}) error {
+	keyVaultsClient := meta.(*clients.Client).KeyVault
+	client := meta.(*clients.Client).KeyVault.ManagementClient
+	resourcesClient := meta.(*clients.Client).Resource
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.ParseNestedItemID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	keyVaultIdRaw, err := keyVaultsClient.KeyVaultIDFromBaseUrl(ctx, resourcesClient, id.KeyVaultBaseUrl)
+	if err != nil {
+		return fmt.Errorf("retrieving the Resource ID the Key Vault at URL %q: %s", id.KeyVaultBaseUrl, err)
+	}
+	if keyVaultIdRaw == nil {
+		log.Printf("[DEBUG] Unable to determine the Resource ID for the Key Vault at URL %q - removing from state!", id.KeyVaultBaseUrl)
+		d.SetId("")
+		return nil
+	}
+
+	keyVaultId, err := parse.VaultID(*keyVaultIdRaw)
+	if err != nil {
+		return err
+	}
+
+	ok, err := keyVaultsClient.Exists(ctx, *keyVaultId)
+	if err != nil {
+		return fmt.Errorf("checking if %s for Certificate %q exists: %v", *keyVaultId, id.Name, err)
+	}
+	if !ok {
+		log.Printf("[DEBUG] Certificate %q was not found in %s - removing from state", id.Name, *keyVaultId)
+		d.SetId("")
+		return nil
+	}
+
+	cert, err := client.GetCertificate(ctx, id.KeyVaultBaseUrl, id.Name, id.Version)
+	if err != nil {
+		if utils.ResponseWasNotFound(cert.Response) {
+			log.Printf("[DEBUG] Certificate %q (Version %q) was not found in Key Vault at URI %q - removing from state", id.Name, id.Version, id.KeyVaultBaseUrl)
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("retrieving Certificate %q (Version %q / Key Vault %q): %+v", id.Name, id.Version, id.KeyVaultBaseUrl, err)
+	}
+
+	// the versioned ID is used when importing, since the ID specified in the config can't be determined
+	if _, ok := d.GetOk("key_vault_certificate_id"); !ok {
+		d.Set("key_vault_certificate_id", id.ID())
+	}
+
+	d.Set("version", id.Version)
+	d.Set("secret_id", cert.Sid)
+
+	certificateData := ""
+	if contents := cert.Cer; contents != nil {
+		certificateData = strings.ToUpper(hex.EncodeToString(*contents))
+	}
+	d.Set("certificate_data", certificateData)
+
+	thumbprint := ""
+	if v := cert.X509Thumbprint; v != nil {
+		x509Thumbprint, err := base64.RawURLEncoding.DecodeString(*v)
+		if err != nil {
+			return err
+		}
+
+		thumbprint = strings.ToUpper(hex.EncodeToString(x509Thumbprint))
+	}
+	d.Set("thumbprint", thumbprint)
+
+	return nil
+}
+
+func resourceKeyVaultCertificateMergeDelete(d *schema.ResourceData, _ interface{}) error {
+	// the signed Certificate can't be un-merged, the Certificate itself is deleted by `azurerm_key_vault_certificate`
+	log.Printf("[DEBUG] The signed Certificate %q can't be removed from the Key Vault Certificate - removing from state only", d.Id())
+	return nil
+}
+
+// expandKeyVaultCertificateMergeX509Certificates returns the DER encoded Certificates within the PEM encoded
+// input, which has already been validated by `keyVaultValidate.CertificatePEM`
+func expandKeyVaultCertificateMergeX509Certificates(input string) [][]byte {
+	output := make([][]byte, 0)
+
+	rest := []byte(input)
+	for {
+		var block *pem.Block
+		block, rest = pem.Decode(rest)
+		if block == nil {
+			break
+		}
+
+		output = append(output, block.Bytes)
+	}
+
+	return output
+}
@@ -0,0 +1,127 @@
+package keyvault_test
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"fmt"
+	"io/ioutil"
+	"math/big"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/acceptance"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/acceptance/check"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/clients"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/keyvault/parse"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+type KeyVaultCertificateMergeResource struct {
+}
+
+func TestAccKeyVaultCertificateMerge_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_key_vault_certificate_merge", "test")
+	r := KeyVaultCertificateMergeResource{}
+
+	// the CSR is only available once the Key Vault Certificate exists, so it's signed between the steps
+	signedCertificatePath := filepath.Join(t.TempDir(), "signed.pem")
+
+	data.ResourceTest(t, r, []resource.TestStep{
+		{
+			Config: KeyVaultCertificateResource{}.basicGenerateUnknownIssuer(data),
+			Check: resource.ComposeTestCheckFunc(
+				r.signCertificateSigningRequest("azurerm_key_vault_certificate.test", signedCertificatePath),
+			),
+		},
+		{
+			Config: r.basic(data, signedCertificatePath),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("thumbprint").Exists(),
+				check.That("azurerm_key_vault_certificate.test").Key("certificate_data").Exists(),
+			),
+		},
+		data.ImportStep("key_vault_certificate_id", "signed_certificate"),
+	})
+}
+
+func (KeyVaultCertificateMergeResource) Exists(ctx context.Context, clients *clients.Client, state *terraform.InstanceState) (*bool, error) {
+	id, err := parse.ParseNestedItemID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	cert, err := clients.KeyVault.ManagementClient.GetCertificate(ctx, id.KeyVaultBaseUrl, id.Name, id.Version)
+	if err != nil {
+		return nil, fmt.Errorf("reading Key Vault Certificate: %+v", err)
+	}
+
+	// the Certificate data is only available once the signed Certificate has been merged
+	return utils.Bool(cert.Cer != nil), nil
+}
+
+// signCertificateSigningRequest signs the CSR exported by the Key Vault Certificate using a throwaway CA
+func (KeyVaultCertificateMergeResource) signCertificateSigningRequest(resourceName, path string) resource.TestCheckFunc {
+	return func(state *terraform.State) error {
+		rs, ok := state.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("%q was not found in the state", resourceName)
+		}
+
+		block, _ := pem.Decode([]byte(rs.Primary.Attributes["certificate_signing_request"]))
+		if block == nil {
+			return fmt.Errorf("`certificate_signing_request` for %q was not PEM encoded", resourceName)
+		}
+		csr, err := x509.ParseCertificateRequest(block.Bytes)
+		if err != nil {
+			return fmt.Errorf("parsing `certificate_signing_request`: %+v", err)
+		}
+
+		caKey, err := rsa.GenerateKey(rand.Reader, 2048)
+		if err != nil {
+			return err
+		}
+		ca := &x509.Certificate{
+			SerialNumber:          big.NewInt(1),
+			Subject:               pkix.Name{CommonName: "acctest-ca"},
+			NotBefore:             time.Now().Add(-time.Hour),
+			NotAfter:              time.Now().Add(24 * time.Hour),
+			IsCA:                  true,
+			BasicConstraintsValid: true,
+			KeyUsage:              x509.KeyUsageCertSign | x509.KeyUsageCRLSign,
+		}
+
+		certificate := &x509.Certificate{
+			SerialNumber: big.NewInt(2),
+			Subject:      csr.Subject,
+			NotBefore:    time.Now().Add(-time.Hour),
+			NotAfter:     time.Now().Add(24 * time.Hour),
+			KeyUsage:     x509.KeyUsageDigitalSignature | x509.KeyUsageKeyEncipherment,
+			ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth},
+		}
+		signed, err := x509.CreateCertificate(rand.Reader, certificate, ca, csr.PublicKey, caKey)
+		if err != nil {
+			return fmt.Errorf("signing `certificate_signing_request`: %+v", err)
+		}
+
+		return ioutil.WriteFile(path, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: signed}), 0600)
+	}
+}
+
+func (KeyVaultCertificateMergeResource) basic(data acceptance.TestData, signedCertificatePath string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_key_vault_certificate_merge" "test" {
+  key_vault_certificate_id = azurerm_key_vault_certificate.test.id
+  signed_certificate       = file(%q)
+}
+`, KeyVaultCertificateResource{}.basicGenerateUnknownIssuer(data), signedCertificatePath)
+}
@@ -5,6 +5,7 @@ import (
 	"crypto/x509"
 	"encoding/base64"
 	"encoding/hex"
+	"encoding/pem"
 	"fmt"
 	"log"
 	"math"
@@ -351,6 +352,11 @@ func resourceKeyVaultCertificate() *schema.Resource {
 				Computed: true,
 			},
 
+			"certificate_signing_request": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
 			"tags": tags.ForceNewSchema(),
 		},
 	}
@@ -572,6 +578,25 @@ func resourceKeyVaultCertificateRead(d *schema.ResourceData, meta interface{}) e
 	}
 	d.Set("thumbprint", thumbprint)
 
+	// certificates using the `Unknown` issuer are pending until a certificate signed by an external CA is merged
+	certificateSigningRequest := ""
+	if policy := cert.Policy; policy != nil && policy.IssuerParameters != nil && policy.IssuerParameters.Name != nil && strings.EqualFold(*policy.IssuerParameters.Name, "unknown") {
+		operation, err := client.GetCertificateOperation(ctx, id.KeyVaultBaseUrl, id.Name)
+		if err != nil {
+			if !utils.ResponseWasNotFound(operation.Response) {
+				return fmt.Errorf("retrieving the pending Operation for Key Vault Certificate %q: %+v", id.Name, err)
+			}
+		}
+
+		if operation.Csr != nil {
+			certificateSigningRequest = string(pem.EncodeToMemory(&pem.Block{
+				Type:  "CERTIFICATE REQUEST",
+				Bytes: *operation.Csr,
+			}))
+		}
+	}
+	d.Set("certificate_signing_request", certificateSigningRequest)
+
 	return tags.FlattenAndSet(d, cert.Tags)
 }
 
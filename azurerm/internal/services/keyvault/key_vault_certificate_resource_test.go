@@ -110,6 +110,7 @@ func TestAccKeyVaultCertificate_basicGenerateUnknownIssuer(t *testing.T) {
 			Config: r.basicGenerateUnknownIssuer(data),
 			Check: resource.ComposeTestCheckFunc(
 				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("certificate_signing_request").Exists(),
 			),
 		},
 		data.ImportStep(),
@@ -37,6 +37,7 @@ func (r Registration) SupportedResources() map[string]*schema.Resource {
 		"azurerm_key_vault_access_policy":      resourceKeyVaultAccessPolicy(),
 		"azurerm_key_vault_certificate":        resourceKeyVaultCertificate(),
 		"azurerm_key_vault_certificate_issuer": resourceKeyVaultCertificateIssuer(),
+		"azurerm_key_vault_certificate_merge":  resourceKeyVaultCertificateMerge(),
 		"azurerm_key_vault_key":                resourceKeyVaultKey(),
 		"azurerm_key_vault_secret":             resourceKeyVaultSecret(),
 		"azurerm_key_vault":                    resourceKeyVault(),
@@ -0,0 +1,50 @@
+package validate
+
+import (
+	"crypto/x509"
+	"encoding/pem"
+	"fmt"
+	"strings"
+)
+
+// CertificatePEM validates that the value is one or more PEM encoded X509 Certificates
+func CertificatePEM(i interface{}, k string) (warnings []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %s to be string", k))
+		return warnings, errors
+	}
+
+	if strings.TrimSpace(v) == "" {
+		errors = append(errors, fmt.Errorf("%s must not be empty", k))
+		return warnings, errors
+	}
+
+	count := 0
+	rest := []byte(v)
+	for {
+		var block *pem.Block
+		block, rest = pem.Decode(rest)
+		if block == nil {
+			break
+		}
+
+		if block.Type != "CERTIFICATE" {
+			errors = append(errors, fmt.Errorf("%s must only contain PEM blocks of the type `CERTIFICATE` but got %q", k, block.Type))
+			return warnings, errors
+		}
+
+		if _, err := x509.ParseCertificate(block.Bytes); err != nil {
+			errors = append(errors, fmt.Errorf("parsing the Certificate in %s: %+v", k, err))
+			return warnings, errors
+		}
+
+		count++
+	}
+
+	if count == 0 {
+		errors = append(errors, fmt.Errorf("%s must contain at least one PEM encoded Certificate", k))
+	}
+
+	return warnings, errors
+}
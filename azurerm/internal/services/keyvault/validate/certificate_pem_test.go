@@ -0,0 +1,81 @@
+package validate
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"testing"
+	"time"
+)
+
+func TestCertificatePEM(t *testing.T) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatalf("generating key: %+v", err)
+	}
+
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: "hello-world"},
+		NotBefore:    time.Now(),
+		NotAfter:     time.Now().Add(time.Hour),
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	if err != nil {
+		t.Fatalf("creating certificate: %+v", err)
+	}
+	certificate := string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}))
+
+	keyDer, err := x509.MarshalECPrivateKey(key)
+	if err != nil {
+		t.Fatalf("marshalling key: %+v", err)
+	}
+	privateKey := string(pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDer}))
+
+	cases := []struct {
+		Input       string
+		ExpectError bool
+	}{
+		{
+			Input:       "",
+			ExpectError: true,
+		},
+		{
+			Input:       "hello-world",
+			ExpectError: true,
+		},
+		{
+			Input:       certificate,
+			ExpectError: false,
+		},
+		{
+			// a certificate followed by its chain
+			Input:       certificate + certificate,
+			ExpectError: false,
+		},
+		{
+			Input:       certificate + privateKey,
+			ExpectError: true,
+		},
+		{
+			Input:       string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: []byte("hello-world")})),
+			ExpectError: true,
+		},
+	}
+
+	for _, tc := range cases {
+		_, errors := CertificatePEM(tc.Input, "signed_certificate")
+
+		hasError := len(errors) > 0
+		if tc.ExpectError && !hasError {
+			t.Fatalf("Expected the Certificate PEM to trigger a validation error for %q", tc.Input)
+		}
+		if !tc.ExpectError && hasError {
+			t.Fatalf("Expected the Certificate PEM not to trigger a validation error for %q: %+v", tc.Input, errors)
+		}
+	}
+}
@@ -3,6 +3,7 @@ package managementgroup
 import (
 	"context"
 	"fmt"
+	"strings"
 	"time"
 
 	"github.com/Azure/azure-sdk-for-go/services/preview/resources/mgmt/2018-03-01-preview/managementgroups"
@@ -57,6 +58,27 @@ func dataSourceManagementGroup() *schema.Resource {
 				Elem:     &schema.Schema{Type: schema.TypeString},
 				Set:      schema.HashString,
 			},
+
+			"management_group_ids": {
+				Type:     schema.TypeSet,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Set:      schema.HashString,
+			},
+
+			"all_subscription_ids": {
+				Type:     schema.TypeSet,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Set:      schema.HashString,
+			},
+
+			"all_management_group_ids": {
+				Type:     schema.TypeSet,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Set:      schema.HashString,
+			},
 		},
 	}
 }
@@ -111,6 +133,9 @@ func dataSourceManagementGroupRead(d *schema.ResourceData, meta interface{}) err
 		}
 		d.Set("subscription_ids", subscriptionIds)
 
+		managementGroupIds := flattenManagementGroupDataSourceManagementGroupIds(props.Children)
+		d.Set("management_group_ids", managementGroupIds)
+
 		parentId := ""
 		if details := props.Details; details != nil {
 			if parent := details.Parent; parent != nil {
@@ -122,6 +147,14 @@ func dataSourceManagementGroupRead(d *schema.ResourceData, meta interface{}) err
 		d.Set("parent_management_group_id", parentId)
 	}
 
+	// the `children` expansion above only covers direct children, the descendants API returns every depth
+	allSubscriptionIds, allManagementGroupIds, err := getManagementGroupDescendants(ctx, client, groupName)
+	if err != nil {
+		return fmt.Errorf("Error retrieving descendants of Management Group %q: %+v", groupName, err)
+	}
+	d.Set("all_subscription_ids", allSubscriptionIds)
+	d.Set("all_management_group_ids", allManagementGroupIds)
+
 	return nil
 }
 
@@ -179,3 +212,54 @@ func flattenManagementGroupDataSourceSubscriptionIds(input *[]managementgroups.C
 
 	return subscriptionIds, nil
 }
+
+func flattenManagementGroupDataSourceManagementGroupIds(input *[]managementgroups.ChildInfo) *schema.Set {
+	managementGroupIds := &schema.Set{F: schema.HashString}
+	if input == nil {
+		return managementGroupIds
+	}
+
+	for _, child := range *input {
+		if child.ID == nil || !strings.HasPrefix(*child.ID, "/providers/Microsoft.Management/managementGroups/") {
+			continue
+		}
+
+		managementGroupIds.Add(*child.ID)
+	}
+
+	return managementGroupIds
+}
+
+func getManagementGroupDescendants(ctx context.Context, client *managementgroups.Client, groupName string) (*schema.Set, *schema.Set, error) {
+	subscriptionIds := &schema.Set{F: schema.HashString}
+	managementGroupIds := &schema.Set{F: schema.HashString}
+
+	iterator, err := client.GetDescendantsComplete(ctx, groupName, "", nil)
+	if err != nil {
+		return nil, nil, fmt.Errorf("listing descendants: %+v", err)
+	}
+
+	for iterator.NotDone() {
+		descendant := iterator.Value()
+		if descendant.ID != nil {
+			if strings.HasPrefix(*descendant.ID, "/providers/Microsoft.Management/managementGroups/") {
+				managementGroupIds.Add(*descendant.ID)
+			} else {
+				id, err := parseManagementGroupSubscriptionID(*descendant.ID)
+				if err != nil {
+					return nil, nil, fmt.Errorf("Unable to parse descendant Subscription ID %+v", err)
+				}
+
+				if id != nil {
+					subscriptionIds.Add(id.subscriptionId)
+				}
+			}
+		}
+
+		if err := iterator.NextWithContext(ctx); err != nil {
+			return nil, nil, fmt.Errorf("listing descendants: %+v", err)
+		}
+	}
+
+	return subscriptionIds, managementGroupIds, nil
+}
@@ -42,6 +42,22 @@ func TestAccManagementGroupDataSource_basicByDisplayName(t *testing.T) {
 	})
 }
 
+func TestAccManagementGroupDataSource_nestedManagementGroup(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azurerm_management_group", "test")
+	r := ManagementGroupDataSource{}
+
+	data.DataSourceTest(t, []resource.TestStep{
+		{
+			Config: r.nestedManagementGroup(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("management_group_ids.#").HasValue("1"),
+				check.That(data.ResourceName).Key("all_management_group_ids.#").HasValue("2"),
+				check.That(data.ResourceName).Key("all_subscription_ids.#").HasValue("0"),
+			),
+		},
+	})
+}
+
 func (ManagementGroupDataSource) basicByName(data acceptance.TestData) string {
 	return fmt.Sprintf(`
 provider "azurerm" {
@@ -73,3 +89,31 @@ data "azurerm_management_group" "test" {
 }
 `, data.RandomInteger)
 }
+
+func (ManagementGroupDataSource) nestedManagementGroup(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_management_group" "parent" {
+  display_name = "acctestmg-%d"
+}
+
+resource "azurerm_management_group" "child" {
+  display_name               = "acctestmg-child-%d"
+  parent_management_group_id = azurerm_management_group.parent.id
+}
+
+resource "azurerm_management_group" "grandchild" {
+  display_name               = "acctestmg-grandchild-%d"
+  parent_management_group_id = azurerm_management_group.child.id
+}
+
+data "azurerm_management_group" "test" {
+  name = azurerm_management_group.parent.name
+
+  depends_on = [azurerm_management_group.grandchild]
+}
+`, data.RandomInteger, data.RandomInteger, data.RandomInteger)
+}
@@ -0,0 +1,316 @@
+package monitor
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+)
+
+func TestMonitorActivityLogAlertArmTemplateDataSourceServiceHealth(t *testing.T) {
+	input := `{
+  "type": "Microsoft.Insights/activityLogAlerts",
+  "name": "example",
+  "properties": {
+    "scopes": ["/subscriptions/00000000-0000-0000-0000-000000000000"],
+    "condition": {
+      "allOf": [
+        { "field": "category", "equals": "ServiceHealth" },
+        { "anyOf": [ { "field": "properties.incidentType", "equals": "Incident" } ] },
+        { "field": "properties.impactedServices[*].ServiceName", "containsAny": ["Action Groups"] },
+        { "field": "properties.impactedServices[*].ImpactedRegions[*].RegionName", "containsAny": ["West Europe"] }
+      ]
+    }
+  }
+}`
+
+	d := dataSourceMonitorActivityLogAlertArmTemplate().Data(nil)
+	if err := d.Set("json", input); err != nil {
+		t.Fatalf("setting `json`: %+v", err)
+	}
+	if err := dataSourceMonitorActivityLogAlertArmTemplateRead(d, nil); err != nil {
+		t.Fatalf("expected no error but got: %+v", err)
+	}
+
+	expected := map[string]string{
+		"activity_log_alert.0.criteria.0.category":                     "ServiceHealth",
+		"activity_log_alert.0.criteria.0.service_health.0.events.0":    "Incident",
+		"activity_log_alert.0.criteria.0.service_health.0.services.0":  "Action Groups",
+		"activity_log_alert.0.criteria.0.service_health.0.locations.0": "West Europe",
+	}
+	for key, value := range expected {
+		if actual := d.Get(key).(string); actual != value {
+			t.Fatalf("expected %q to be %q but got %q", key, value, actual)
+		}
+	}
+}
+
+func TestMonitorActivityLogAlertCustomizeDiffServiceHealth(t *testing.T) {
+	cases := []struct {
+		Name     string
+		Category string
+		Error    bool
+	}{
+		{
+			Name:     "ServiceHealth",
+			Category: "ServiceHealth",
+			Error:    false,
+		},
+		{
+			Name:     "Administrative",
+			Category: "Administrative",
+			Error:    true,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Logf("[DEBUG] Testing %q..", tc.Name)
+
+		config := terraform.NewResourceConfigRaw(map[string]interface{}{
+			"name":                "example",
+			"resource_group_name": "example",
+			"scopes":              []interface{}{"/subscriptions/00000000-0000-0000-0000-000000000000"},
+			"criteria": []interface{}{
+				map[string]interface{}{
+					"category": tc.Category,
+					"service_health": []interface{}{
+						map[string]interface{}{
+							"events": []interface{}{"Incident"},
+						},
+					},
+				},
+			},
+		})
+
+		_, err := resourceMonitorActivityLogAlert().Diff(nil, config, nil)
+		if tc.Error != (err != nil) {
+			t.Fatalf("expected error to be %t but got: %+v", tc.Error, err)
+		}
+		if err != nil && !strings.Contains(err.Error(), "`service_health` can only be specified") {
+			t.Fatalf("unexpected error: %+v", err)
+		}
+	}
+}
+
+func TestParseMonitorActivityLogAlertArmTemplate(t *testing.T) {
+	cases := []struct {
+		Name          string
+		Input         string
+		Error         bool
+		ExpectedNames []string
+	}{
+		{
+			Name:  "Not JSON",
+			Input: "hello world",
+			Error: true,
+		},
+		{
+			Name:  "Resources is not a List",
+			Input: `{"resources": "hello"}`,
+			Error: true,
+		},
+		{
+			Name:  "No Activity Log Alerts",
+			Input: `{"resources": [{"type": "Microsoft.Insights/actionGroups", "name": "group1"}]}`,
+			Error: true,
+		},
+		{
+			Name:  "Empty Resources",
+			Input: `{"resources": []}`,
+			Error: true,
+		},
+		{
+			Name:  "Invalid Activity Log Alert",
+			Input: `{"type": "Microsoft.Insights/activityLogAlerts", "name": "alert1", "properties": {"enabled": "yes"}}`,
+			Error: true,
+		},
+		{
+			Name:          "Single Resource",
+			Input:         `{"type": "Microsoft.Insights/activityLogAlerts", "name": "alert1"}`,
+			ExpectedNames: []string{"alert1"},
+		},
+		{
+			Name: "Template",
+			Input: `{
+  "resources": [
+    "hello",
+    {"type": "Microsoft.Insights/actionGroups", "name": "group1"},
+    {"type": "microsoft.insights/activitylogalerts", "name": "alert1"},
+    {"type": "Microsoft.Insights/activityLogAlerts", "name": "alert2"}
+  ]
+}`,
+			ExpectedNames: []string{"alert1", "alert2"},
+		},
+		{
+			Name: "Template with Parameters",
+			Input: `{
+  "parameters": {
+    "Alert_Name": {"defaultValue": "alert1", "type": "String"}
+  },
+  "resources": [
+    {"type": "Microsoft.Insights/activityLogAlerts", "name": "[parameters('alert_name')]"}
+  ]
+}`,
+			ExpectedNames: []string{"alert1"},
+		},
+		{
+			Name: "Template with Missing Parameter",
+			Input: `{
+  "parameters": {},
+  "resources": [
+    {"type": "Microsoft.Insights/activityLogAlerts", "name": "[parameters('alert_name')]"}
+  ]
+}`,
+			Error: true,
+		},
+		{
+			Name: "Template with Parameter without a Default Value",
+			Input: `{
+  "parameters": {
+    "alert_name": {"type": "String"}
+  },
+  "resources": [
+    {"type": "Microsoft.Insights/activityLogAlerts", "name": "[parameters('alert_name')]"}
+  ]
+}`,
+			Error: true,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Logf("[DEBUG] Testing %q..", tc.Name)
+
+		alerts, err := parseMonitorActivityLogAlertArmTemplate(tc.Input)
+		if err != nil {
+			if tc.Error {
+				continue
+			}
+
+			t.Fatalf("expected no error but got: %+v", err)
+		}
+
+		if tc.Error {
+			t.Fatalf("expected an error but didn't get one")
+		}
+
+		names := make([]string, 0)
+		for _, alert := range alerts {
+			if alert.Name != nil {
+				names = append(names, *alert.Name)
+			}
+		}
+		if !reflect.DeepEqual(tc.ExpectedNames, names) {
+			t.Fatalf("expected %+v but got %+v", tc.ExpectedNames, names)
+		}
+	}
+}
+
+func TestResolveArmTemplateParameters(t *testing.T) {
+	parameters := map[string]interface{}{
+		"name":    "alert1",
+		"enabled": false,
+		"scopes":  []interface{}{"/subscriptions/00000000-0000-0000-0000-000000000000"},
+		"empty":   nil,
+	}
+
+	cases := []struct {
+		Name     string
+		Input    interface{}
+		Expected interface{}
+		Error    bool
+	}{
+		{
+			Name:     "Plain String",
+			Input:    "alert1",
+			Expected: "alert1",
+		},
+		{
+			Name:     "String Parameter",
+			Input:    "[parameters('name')]",
+			Expected: "alert1",
+		},
+		{
+			Name:     "Parameter Name is Case Insensitive",
+			Input:    "[parameters('Name')]",
+			Expected: "alert1",
+		},
+		{
+			Name:     "Bool Parameter",
+			Input:    "[parameters('enabled')]",
+			Expected: false,
+		},
+		{
+			Name:  "Missing Parameter",
+			Input: "[parameters('missing')]",
+			Error: true,
+		},
+		{
+			Name:  "Parameter without a Default Value",
+			Input: "[parameters('empty')]",
+			Error: true,
+		},
+		{
+			Name: "Nested Parameter without a Default Value",
+			Input: map[string]interface{}{
+				"properties": map[string]interface{}{
+					"actions": []interface{}{
+						"[parameters('empty')]",
+					},
+				},
+			},
+			Error: true,
+		},
+		{
+			Name:     "Other Expression",
+			Input:    "[concat(parameters('name'), '-suffix')]",
+			Expected: "[concat(parameters('name'), '-suffix')]",
+		},
+		{
+			Name: "Nested",
+			Input: map[string]interface{}{
+				"name": "[parameters('name')]",
+				"properties": map[string]interface{}{
+					"enabled": "[parameters('enabled')]",
+					"scopes":  "[parameters('scopes')]",
+					"actions": []interface{}{
+						"[parameters('name')]",
+						float64(1),
+					},
+				},
+			},
+			Expected: map[string]interface{}{
+				"name": "alert1",
+				"properties": map[string]interface{}{
+					"enabled": false,
+					"scopes":  []interface{}{"/subscriptions/00000000-0000-0000-0000-000000000000"},
+					"actions": []interface{}{
+						"alert1",
+						float64(1),
+					},
+				},
+			},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Logf("[DEBUG] Testing %q..", tc.Name)
+
+		actual, err := resolveArmTemplateParameters(tc.Input, parameters)
+		if err != nil {
+			if tc.Error {
+				continue
+			}
+
+			t.Fatalf("expected no error but got: %+v", err)
+		}
+
+		if tc.Error {
+			t.Fatalf("expected an error but didn't get one")
+		}
+
+		if !reflect.DeepEqual(tc.Expected, actual) {
+			t.Fatalf("expected %+v but got %+v", tc.Expected, actual)
+		}
+	}
+}
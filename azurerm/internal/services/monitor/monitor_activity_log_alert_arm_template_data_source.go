@@ -0,0 +1,344 @@
+package monitor
+
+import (
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/monitor/mgmt/2020-10-01/insights"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/hashcode"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/tags"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+const activityLogAlertResourceType = "microsoft.insights/activitylogalerts"
+
+var armTemplateParameterExpression = regexp.MustCompile(`^\[parameters\('([^']+)'\)\]$`)
+
+func dataSourceMonitorActivityLogAlertArmTemplate() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceMonitorActivityLogAlertArmTemplateRead,
+
+		Timeouts: &schema.ResourceTimeout{
+			Read: schema.DefaultTimeout(5 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"json": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringIsJSON,
+			},
+
+			"activity_log_alert": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"scopes": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+							},
+						},
+
+						"criteria": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"category": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"operation_name": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"caller": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"level": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"resource_provider": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"resource_type": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"resource_group": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"resource_id": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"status": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"sub_status": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"recommendation_category": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"recommendation_impact": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"recommendation_type": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"service_health": {
+										Type:     schema.TypeList,
+										Computed: true,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"events": {
+													Type:     schema.TypeList,
+													Computed: true,
+													Elem: &schema.Schema{
+														Type: schema.TypeString,
+													},
+												},
+												"locations": {
+													Type:     schema.TypeList,
+													Computed: true,
+													Elem: &schema.Schema{
+														Type: schema.TypeString,
+													},
+												},
+												"services": {
+													Type:     schema.TypeList,
+													Computed: true,
+													Elem: &schema.Schema{
+														Type: schema.TypeString,
+													},
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+
+						"action": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"action_group_id": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"webhook_properties": {
+										Type:     schema.TypeMap,
+										Computed: true,
+										Elem: &schema.Schema{
+											Type: schema.TypeString,
+										},
+									},
+								},
+							},
+						},
+
+						"description": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"enabled": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+
+						"tags": tags.SchemaDataSource(),
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceMonitorActivityLogAlertArmTemplateRead(d *schema.ResourceData, _ interface{}) error {
+	input := d.Get("json").(string)
+
+	alerts, err := parseMonitorActivityLogAlertArmTemplate(input)
+	if err != nil {
+		return fmt.Errorf("parsing the Activity Log Alert ARM Template: %+v", err)
+	}
+
+	d.SetId(fmt.Sprintf("%d", hashcode.String(input)))
+
+	if err := d.Set("activity_log_alert", flattenMonitorActivityLogAlertArmTemplateAlerts(alerts)); err != nil {
+		return fmt.Errorf("setting `activity_log_alert`: %+v", err)
+	}
+
+	return nil
+}
+
+// parseMonitorActivityLogAlertArmTemplate parses either a single exported Activity Log Alert resource or a full
+// ARM Template (as exported from the Portal) containing one or more Activity Log Alerts. Values referencing a
+// template parameter (e.g. `[parameters('name')]`) are replaced with that parameter's default value,
+// which must be set.
+func parseMonitorActivityLogAlertArmTemplate(input string) ([]insights.ActivityLogAlertResource, error) {
+	var template struct {
+		Parameters map[string]struct {
+			DefaultValue interface{} `json:"defaultValue"`
+		} `json:"parameters"`
+		Resources []interface{} `json:"resources"`
+	}
+	if err := json.Unmarshal([]byte(input), &template); err != nil {
+		return nil, err
+	}
+
+	resources := template.Resources
+	if resources == nil {
+		var resource interface{}
+		if err := json.Unmarshal([]byte(input), &resource); err != nil {
+			return nil, err
+		}
+		resources = []interface{}{resource}
+	}
+
+	parameters := make(map[string]interface{})
+	for name, parameter := range template.Parameters {
+		parameters[strings.ToLower(name)] = parameter.DefaultValue
+	}
+
+	alerts := make([]insights.ActivityLogAlertResource, 0)
+	for _, raw := range resources {
+		resource, ok := raw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		if resourceType, ok := resource["type"].(string); !ok || !strings.EqualFold(resourceType, activityLogAlertResourceType) {
+			continue
+		}
+
+		resolved, err := resolveArmTemplateParameters(resource, parameters)
+		if err != nil {
+			return nil, err
+		}
+
+		body, err := json.Marshal(resolved)
+		if err != nil {
+			return nil, err
+		}
+
+		var alert insights.ActivityLogAlertResource
+		if err := json.Unmarshal(body, &alert); err != nil {
+			return nil, fmt.Errorf("unmarshalling Activity Log Alert: %+v", err)
+		}
+		alerts = append(alerts, alert)
+	}
+
+	if len(alerts) == 0 {
+		return nil, fmt.Errorf("no resources of type %q were found", "Microsoft.Insights/activityLogAlerts")
+	}
+
+	return alerts, nil
+}
+
+// resolveArmTemplateParameters replaces any values referencing a template parameter with that parameter's default
+// value - returning an error when the parameter doesn't exist or has no default value, since it can't be resolved
+func resolveArmTemplateParameters(input interface{}, parameters map[string]interface{}) (interface{}, error) {
+	switch v := input.(type) {
+	case string:
+		matches := armTemplateParameterExpression.FindStringSubmatch(v)
+		if len(matches) != 2 {
+			return v, nil
+		}
+
+		value, ok := parameters[strings.ToLower(matches[1])]
+		if !ok {
+			return nil, fmt.Errorf("the template parameter %q referenced by %q was not found", matches[1], v)
+		}
+		if value == nil {
+			return nil, fmt.Errorf("the template parameter %q referenced by %q has no `defaultValue`", matches[1], v)
+		}
+		return value, nil
+
+	case []interface{}:
+		output := make([]interface{}, 0)
+		for _, item := range v {
+			resolved, err := resolveArmTemplateParameters(item, parameters)
+			if err != nil {
+				return nil, err
+			}
+			output = append(output, resolved)
+		}
+		return output, nil
+
+	case map[string]interface{}:
+		output := make(map[string]interface{})
+		for key, item := range v {
+			resolved, err := resolveArmTemplateParameters(item, parameters)
+			if err != nil {
+				return nil, err
+			}
+			output[key] = resolved
+		}
+		return output, nil
+	}
+
+	return input, nil
+}
+
+func flattenMonitorActivityLogAlertArmTemplateAlerts(input []insights.ActivityLogAlertResource) []interface{} {
+	results := make([]interface{}, 0)
+
+	for _, alert := range input {
+		name := ""
+		if alert.Name != nil {
+			name = *alert.Name
+		}
+
+		result := map[string]interface{}{
+			"name":        name,
+			"scopes":      []interface{}{},
+			"criteria":    flattenMonitorActivityLogAlertCriteria(nil),
+			"action":      flattenMonitorActivityLogAlertAction(nil),
+			"description": "",
+			"enabled":     true,
+			"tags":        tags.Flatten(alert.Tags),
+		}
+
+		if props := alert.AlertRuleProperties; props != nil {
+			result["scopes"] = utils.FlattenStringSlice(props.Scopes)
+			result["criteria"] = flattenMonitorActivityLogAlertCriteria(props.Condition)
+			result["action"] = flattenMonitorActivityLogAlertAction(props.Actions)
+
+			if props.Description != nil {
+				result["description"] = *props.Description
+			}
+			if props.Enabled != nil {
+				result["enabled"] = *props.Enabled
+			}
+		}
+
+		results = append(results, result)
+	}
+
+	return results
+}
@@ -0,0 +1,211 @@
+package monitor_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/acceptance"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/acceptance/check"
+)
+
+type MonitorActivityLogAlertArmTemplateDataSource struct {
+}
+
+func TestAccDataSourceMonitorActivityLogAlertArmTemplate_template(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azurerm_monitor_activity_log_alert_arm_template", "test")
+	r := MonitorActivityLogAlertArmTemplateDataSource{}
+
+	data.DataSourceTest(t, []resource.TestStep{
+		{
+			Config: r.template(),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("activity_log_alert.#").HasValue("1"),
+				check.That(data.ResourceName).Key("activity_log_alert.0.name").HasValue("acctestalert"),
+				check.That(data.ResourceName).Key("activity_log_alert.0.enabled").HasValue("false"),
+				check.That(data.ResourceName).Key("activity_log_alert.0.description").HasValue("Exported from the Portal"),
+				check.That(data.ResourceName).Key("activity_log_alert.0.scopes.#").HasValue("1"),
+				check.That(data.ResourceName).Key("activity_log_alert.0.scopes.0").HasValue("/subscriptions/00000000-0000-0000-0000-000000000000"),
+				check.That(data.ResourceName).Key("activity_log_alert.0.criteria.0.category").HasValue("Administrative"),
+				check.That(data.ResourceName).Key("activity_log_alert.0.criteria.0.operation_name").HasValue("Microsoft.Storage/storageAccounts/write"),
+				check.That(data.ResourceName).Key("activity_log_alert.0.criteria.0.level").HasValue("Error"),
+				check.That(data.ResourceName).Key("activity_log_alert.0.action.#").HasValue("1"),
+				check.That(data.ResourceName).Key("activity_log_alert.0.action.0.action_group_id").HasValue("/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/microsoft.insights/actionGroups/actiongroup1"),
+				check.That(data.ResourceName).Key("activity_log_alert.0.action.0.webhook_properties.from").HasValue("terraform"),
+			),
+		},
+	})
+}
+
+func TestAccDataSourceMonitorActivityLogAlertArmTemplate_resource(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azurerm_monitor_activity_log_alert_arm_template", "test")
+	r := MonitorActivityLogAlertArmTemplateDataSource{}
+
+	data.DataSourceTest(t, []resource.TestStep{
+		{
+			Config: r.resource(),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("activity_log_alert.#").HasValue("1"),
+				check.That(data.ResourceName).Key("activity_log_alert.0.name").HasValue("acctestalert"),
+				check.That(data.ResourceName).Key("activity_log_alert.0.enabled").HasValue("true"),
+				check.That(data.ResourceName).Key("activity_log_alert.0.criteria.0.category").HasValue("ServiceHealth"),
+				check.That(data.ResourceName).Key("activity_log_alert.0.action.#").HasValue("0"),
+			),
+		},
+	})
+}
+
+func TestAccDataSourceMonitorActivityLogAlertArmTemplate_serviceHealth(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azurerm_monitor_activity_log_alert_arm_template", "test")
+	r := MonitorActivityLogAlertArmTemplateDataSource{}
+
+	data.DataSourceTest(t, []resource.TestStep{
+		{
+			Config: r.serviceHealth(),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("activity_log_alert.#").HasValue("1"),
+				check.That(data.ResourceName).Key("activity_log_alert.0.criteria.0.category").HasValue("ServiceHealth"),
+				check.That(data.ResourceName).Key("activity_log_alert.0.criteria.0.service_health.#").HasValue("1"),
+				check.That(data.ResourceName).Key("activity_log_alert.0.criteria.0.service_health.0.events.#").HasValue("2"),
+				check.That(data.ResourceName).Key("activity_log_alert.0.criteria.0.service_health.0.events.0").HasValue("Incident"),
+				check.That(data.ResourceName).Key("activity_log_alert.0.criteria.0.service_health.0.events.1").HasValue("Maintenance"),
+				check.That(data.ResourceName).Key("activity_log_alert.0.criteria.0.service_health.0.locations.#").HasValue("1"),
+				check.That(data.ResourceName).Key("activity_log_alert.0.criteria.0.service_health.0.locations.0").HasValue("West Europe"),
+				check.That(data.ResourceName).Key("activity_log_alert.0.criteria.0.service_health.0.services.#").HasValue("1"),
+				check.That(data.ResourceName).Key("activity_log_alert.0.criteria.0.service_health.0.services.0").HasValue("Action Groups"),
+			),
+		},
+	})
+}
+
+func (MonitorActivityLogAlertArmTemplateDataSource) template() string {
+	return `
+data "azurerm_monitor_activity_log_alert_arm_template" "test" {
+  json = <<JSON
+{
+  "$schema": "https://schema.management.azure.com/schemas/2019-04-01/deploymentTemplate.json#",
+  "contentVersion": "1.0.0.0",
+  "parameters": {
+    "activityLogAlerts_acctestalert_name": {
+      "defaultValue": "acctestalert",
+      "type": "String"
+    },
+    "actionGroups_actiongroup1_externalid": {
+      "defaultValue": "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/microsoft.insights/actionGroups/actiongroup1",
+      "type": "String"
+    }
+  },
+  "variables": {},
+  "resources": [
+    {
+      "type": "microsoft.insights/activityLogAlerts",
+      "apiVersion": "2017-04-01",
+      "name": "[parameters('activityLogAlerts_acctestalert_name')]",
+      "location": "Global",
+      "properties": {
+        "scopes": [
+          "/subscriptions/00000000-0000-0000-0000-000000000000"
+        ],
+        "condition": {
+          "allOf": [
+            {
+              "field": "category",
+              "equals": "Administrative"
+            },
+            {
+              "field": "operationName",
+              "equals": "Microsoft.Storage/storageAccounts/write"
+            },
+            {
+              "field": "level",
+              "equals": "Error"
+            }
+          ]
+        },
+        "actions": {
+          "actionGroups": [
+            {
+              "actionGroupId": "[parameters('actionGroups_actiongroup1_externalid')]",
+              "webhookProperties": {
+                "from": "terraform"
+              }
+            }
+          ]
+        },
+        "enabled": false,
+        "description": "Exported from the Portal"
+      }
+    }
+  ]
+}
+JSON
+}
+`
+}
+
+func (MonitorActivityLogAlertArmTemplateDataSource) resource() string {
+	return `
+data "azurerm_monitor_activity_log_alert_arm_template" "test" {
+  json = jsonencode({
+    type     = "Microsoft.Insights/activityLogAlerts"
+    name     = "acctestalert"
+    location = "Global"
+    properties = {
+      scopes = ["/subscriptions/00000000-0000-0000-0000-000000000000"]
+      condition = {
+        allOf = [
+          {
+            field  = "category"
+            equals = "ServiceHealth"
+          }
+        ]
+      }
+      enabled = true
+    }
+  })
+}
+`
+}
+
+func (MonitorActivityLogAlertArmTemplateDataSource) serviceHealth() string {
+	return `
+data "azurerm_monitor_activity_log_alert_arm_template" "test" {
+  json = jsonencode({
+    type     = "Microsoft.Insights/activityLogAlerts"
+    name     = "acctestalert"
+    location = "Global"
+    properties = {
+      scopes = ["/subscriptions/00000000-0000-0000-0000-000000000000"]
+      condition = {
+        allOf = [
+          {
+            field  = "category"
+            equals = "ServiceHealth"
+          },
+          {
+            anyOf = [
+              {
+                field  = "properties.incidentType"
+                equals = "Incident"
+              },
+              {
+                field  = "properties.incidentType"
+                equals = "Maintenance"
+              }
+            ]
+          },
+          {
+            field       = "properties.impactedServices[*].ServiceName"
+            containsAny = ["Action Groups"]
+          },
+          {
+            field       = "properties.impactedServices[*].ImpactedRegions[*].RegionName"
+            containsAny = ["West Europe"]
+          }
+        ]
+      }
+      enabled = true
+    }
+  })
+}
+`
+}
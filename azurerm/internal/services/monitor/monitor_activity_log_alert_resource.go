@@ -151,6 +151,48 @@ func resourceMonitorActivityLogAlert() *schema.Resource {
 							Optional:      true,
 							ConflictsWith: []string{"criteria.0.recommendation_category", "criteria.0.recommendation_impact"},
 						},
+						"service_health": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"events": {
+										Type:     schema.TypeSet,
+										Optional: true,
+										Elem: &schema.Schema{
+											Type: schema.TypeString,
+											ValidateFunc: validation.StringInSlice([]string{
+												"ActionRequired",
+												"Incident",
+												"Informational",
+												"Maintenance",
+												"Security",
+											}, false),
+										},
+										Set: schema.HashString,
+									},
+									"locations": {
+										Type:     schema.TypeSet,
+										Optional: true,
+										Elem: &schema.Schema{
+											Type:         schema.TypeString,
+											ValidateFunc: validation.StringIsNotEmpty,
+										},
+										Set: schema.HashString,
+									},
+									"services": {
+										Type:     schema.TypeSet,
+										Optional: true,
+										Elem: &schema.Schema{
+											Type:         schema.TypeString,
+											ValidateFunc: validation.StringIsNotEmpty,
+										},
+										Set: schema.HashString,
+									},
+								},
+							},
+						},
 					},
 				},
 			},
@@ -190,9 +232,25 @@ func resourceMonitorActivityLogAlert() *schema.Resource {
 
 			"tags": tags.Schema(),
 		},
+
+		CustomizeDiff: resourceMonitorActivityLogAlertCustomizeDiff,
 	}
 }
 
+func resourceMonitorActivityLogAlertCustomizeDiff(d *schema.ResourceDiff, _ interface{}) error {
+	criteriaRaw := d.Get("criteria").([]interface{})
+	if len(criteriaRaw) == 0 || criteriaRaw[0] == nil || !d.NewValueKnown("criteria.0.category") {
+		return nil
+	}
+
+	criteria := criteriaRaw[0].(map[string]interface{})
+	if len(criteria["service_health"].([]interface{})) > 0 && criteria["category"].(string) != "ServiceHealth" {
+		return fmt.Errorf("`service_health` can only be specified when the `category` is `ServiceHealth`")
+	}
+
+	return nil
+}
+
 func resourceMonitorActivityLogAlertCreateUpdate(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*clients.Client).Monitor.ActivityLogAlertsClient
 	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
@@ -397,11 +455,53 @@ func expandMonitorActivityLogAlertCriteria(input []interface{}) *insights.AlertR
 		})
 	}
 
+	if serviceHealth := v["service_health"].([]interface{}); len(serviceHealth) > 0 {
+		conditions = append(conditions, expandMonitorActivityLogAlertServiceHealth(serviceHealth)...)
+	}
+
 	return &insights.AlertRuleAllOfCondition{
 		AllOf: &conditions,
 	}
 }
 
+func expandMonitorActivityLogAlertServiceHealth(input []interface{}) []insights.AlertRuleAnyOfOrLeafCondition {
+	conditions := make([]insights.AlertRuleAnyOfOrLeafCondition, 0)
+	if len(input) == 0 || input[0] == nil {
+		return conditions
+	}
+	v := input[0].(map[string]interface{})
+
+	// each of the Events is an alternative, so these are combined into a single `anyOf` condition
+	if events := v["events"].(*schema.Set).List(); len(events) > 0 {
+		anyOf := make([]insights.AlertRuleLeafCondition, 0)
+		for _, event := range events {
+			anyOf = append(anyOf, insights.AlertRuleLeafCondition{
+				Field:  utils.String("properties.incidentType"),
+				Equals: utils.String(event.(string)),
+			})
+		}
+		conditions = append(conditions, insights.AlertRuleAnyOfOrLeafCondition{
+			AnyOf: &anyOf,
+		})
+	}
+
+	if services := v["services"].(*schema.Set).List(); len(services) > 0 {
+		conditions = append(conditions, insights.AlertRuleAnyOfOrLeafCondition{
+			Field:       utils.String("properties.impactedServices[*].ServiceName"),
+			ContainsAny: utils.ExpandStringSlice(services),
+		})
+	}
+
+	if locations := v["locations"].(*schema.Set).List(); len(locations) > 0 {
+		conditions = append(conditions, insights.AlertRuleAnyOfOrLeafCondition{
+			Field:       utils.String("properties.impactedServices[*].ImpactedRegions[*].RegionName"),
+			ContainsAny: utils.ExpandStringSlice(locations),
+		})
+	}
+
+	return conditions
+}
+
 func expandMonitorActivityLogAlertAction(input []interface{}) *insights.ActionList {
 	actions := make([]insights.ActionGroup, 0)
 	for _, item := range input {
@@ -425,12 +525,36 @@ func expandMonitorActivityLogAlertAction(input []interface{}) *insights.ActionLi
 	}
 }
 
-func flattenMonitorActivityLogAlertCriteria(input *insights.ActivityLogAlertAllOfCondition) []interface{} {
+func flattenMonitorActivityLogAlertCriteria(input *insights.AlertRuleAllOfCondition) []interface{} {
 	result := make(map[string]interface{})
 	if input == nil || input.AllOf == nil {
 		return []interface{}{result}
 	}
+	serviceHealth := make(map[string]interface{})
 	for _, condition := range *input.AllOf {
+		if condition.AnyOf != nil {
+			events := make([]interface{}, 0)
+			for _, leaf := range *condition.AnyOf {
+				if leaf.Field != nil && strings.EqualFold(*leaf.Field, "properties.incidentType") && leaf.Equals != nil {
+					events = append(events, *leaf.Equals)
+				}
+			}
+			if len(events) > 0 {
+				serviceHealth["events"] = events
+			}
+			continue
+		}
+
+		if condition.Field != nil && condition.ContainsAny != nil {
+			switch strings.ToLower(*condition.Field) {
+			case "properties.impactedservices[*].servicename":
+				serviceHealth["services"] = utils.FlattenStringSlice(condition.ContainsAny)
+			case "properties.impactedservices[*].impactedregions[*].regionname":
+				serviceHealth["locations"] = utils.FlattenStringSlice(condition.ContainsAny)
+			}
+			continue
+		}
+
 		if condition.Field != nil && condition.Equals != nil {
 			switch strings.ToLower(*condition.Field) {
 			case "operationname":
@@ -456,10 +580,13 @@ func flattenMonitorActivityLogAlertCriteria(input *insights.ActivityLogAlertAllO
 			}
 		}
 	}
+	if len(serviceHealth) > 0 {
+		result["service_health"] = []interface{}{serviceHealth}
+	}
 	return []interface{}{result}
 }
 
-func flattenMonitorActivityLogAlertAction(input *insights.ActivityLogAlertActionList) (result []interface{}) {
+func flattenMonitorActivityLogAlertAction(input *insights.ActionList) (result []interface{}) {
 	result = make([]interface{}, 0)
 	if input == nil || input.ActionGroups == nil {
 		return
@@ -103,6 +103,25 @@ func TestAccMonitorActivityLogAlert_complete(t *testing.T) {
 	})
 }
 
+func TestAccMonitorActivityLogAlert_serviceHealth(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_monitor_activity_log_alert", "test")
+	r := MonitorActivityLogAlertResource{}
+
+	data.ResourceTest(t, r, []resource.TestStep{
+		{
+			Config: r.serviceHealth(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("criteria.0.category").HasValue("ServiceHealth"),
+				check.That(data.ResourceName).Key("criteria.0.service_health.0.events.#").HasValue("2"),
+				check.That(data.ResourceName).Key("criteria.0.service_health.0.locations.#").HasValue("2"),
+				check.That(data.ResourceName).Key("criteria.0.service_health.0.services.#").HasValue("1"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
 func TestAccMonitorActivityLogAlert_basicAndCompleteUpdate(t *testing.T) {
 	data := acceptance.BuildTestData(t, "azurerm_monitor_activity_log_alert", "test")
 	r := MonitorActivityLogAlertResource{}
@@ -183,6 +202,37 @@ resource "azurerm_monitor_activity_log_alert" "test" {
 `, data.RandomInteger, data.Locations.Primary, data.RandomInteger)
 }
 
+func (MonitorActivityLogAlertResource) serviceHealth(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+data "azurerm_subscription" "current" {}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_monitor_activity_log_alert" "test" {
+  name                = "acctestActivityLogAlert-%d"
+  resource_group_name = azurerm_resource_group.test.name
+  scopes              = [data.azurerm_subscription.current.id]
+
+  criteria {
+    category = "ServiceHealth"
+
+    service_health {
+      events    = ["Incident", "Maintenance"]
+      locations = ["Global", "West Europe"]
+      services  = ["Virtual Machines"]
+    }
+  }
+}
+`, data.RandomInteger, data.Locations.Primary, data.RandomInteger)
+}
+
 func (r MonitorActivityLogAlertResource) requiresImport(data acceptance.TestData) string {
 	return fmt.Sprintf(`
 %s
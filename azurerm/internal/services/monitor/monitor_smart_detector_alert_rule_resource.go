@@ -57,9 +57,21 @@ func resourceMonitorSmartDetectorAlertRule() *schema.Resource {
 				Required: true,
 				ValidateFunc: validation.StringInSlice([]string{
 					"FailureAnomaliesDetector",
+					"RequestPerformanceDegradationDetector",
+					"DependencyPerformanceDegradationDetector",
+					"ExceptionVolumeChangedDetector",
+					"TraceSeverityDetector",
+					"MemoryLeakDetector",
 				}, false),
 			},
 
+			"detector_parameters": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ValidateFunc:     validation.StringIsJSON,
+				DiffSuppressFunc: structure.SuppressJsonDiff,
+			},
+
 			"scope_resource_ids": {
 				Type:     schema.TypeSet,
 				Required: true,
@@ -178,6 +190,8 @@ func resourceMonitorSmartDetectorAlertRuleCreateUpdate(d *schema.ResourceData, m
 			Frequency:   utils.String(d.Get("frequency").(string)),
 			Detector: &alertsmanagement.Detector{
 				ID: utils.String(d.Get("detector_type").(string)),
+				// always sent, so that removing the parameters resets the Smart Detector to its defaults
+				Parameters: map[string]interface{}{},
 			},
 			Scope:        utils.ExpandStringSlice(d.Get("scope_resource_ids").(*schema.Set).List()),
 			ActionGroups: expandMonitorSmartDetectorAlertRuleActionGroup(d.Get("action_group").([]interface{})),
@@ -185,6 +199,14 @@ func resourceMonitorSmartDetectorAlertRuleCreateUpdate(d *schema.ResourceData, m
 		Tags: tags.Expand(d.Get("tags").(map[string]interface{})),
 	}
 
+	if v := d.Get("detector_parameters").(string); v != "" {
+		parameters, err := structure.ExpandJsonFromString(v)
+		if err != nil {
+			return fmt.Errorf("expanding `detector_parameters`: %+v", err)
+		}
+		actionRule.AlertRuleProperties.Detector.Parameters = parameters
+	}
+
 	if v, ok := d.GetOk("throttling_duration"); ok {
 		actionRule.AlertRuleProperties.Throttling = &alertsmanagement.ThrottlingInformation{
 			Duration: utils.String(v.(string)),
@@ -239,6 +261,15 @@ func resourceMonitorSmartDetectorAlertRuleRead(d *schema.ResourceData, meta inte
 
 		if props.Detector != nil {
 			d.Set("detector_type", props.Detector.ID)
+
+			detectorParameters := ""
+			if len(props.Detector.Parameters) > 0 {
+				detectorParameters, err = structure.FlattenJsonToString(props.Detector.Parameters)
+				if err != nil {
+					return fmt.Errorf("flattening `detector_parameters`: %+v", err)
+				}
+			}
+			d.Set("detector_parameters", detectorParameters)
 		}
 
 		throttlingDuration := ""
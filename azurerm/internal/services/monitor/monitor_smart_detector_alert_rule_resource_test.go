@@ -61,6 +61,28 @@ func TestAccMonitorSmartDetectorAlertRule_complete(t *testing.T) {
 	})
 }
 
+func TestAccMonitorSmartDetectorAlertRule_detectorType(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_monitor_smart_detector_alert_rule", "test")
+	r := MonitorSmartDetectorAlertRuleResource{}
+
+	data.ResourceTest(t, r, []resource.TestStep{
+		{
+			Config: r.detectorType(data, "RequestPerformanceDegradationDetector"),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.detectorType(data, "DependencyPerformanceDegradationDetector"),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
 func TestAccMonitorSmartDetectorAlertRule_update(t *testing.T) {
 	data := acceptance.BuildTestData(t, "azurerm_monitor_smart_detector_alert_rule", "test")
 	r := MonitorSmartDetectorAlertRuleResource{}
@@ -90,6 +112,36 @@ func TestAccMonitorSmartDetectorAlertRule_update(t *testing.T) {
 	})
 }
 
+func TestAccMonitorSmartDetectorAlertRule_detectorParameters(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_monitor_smart_detector_alert_rule", "test")
+	r := MonitorSmartDetectorAlertRuleResource{}
+
+	data.ResourceTest(t, r, []resource.TestStep{
+		{
+			Config: r.detectorParameters(data, "Medium"),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.detectorParameters(data, "High"),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.basic(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("detector_parameters").HasValue(""),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
 func (t MonitorSmartDetectorAlertRuleResource) Exists(ctx context.Context, clients *clients.Client, state *terraform.InstanceState) (*bool, error) {
 	id, err := parse.SmartDetectorAlertRuleID(state.ID)
 	if err != nil {
@@ -123,6 +175,48 @@ resource "azurerm_monitor_smart_detector_alert_rule" "test" {
 `, r.template(data), data.RandomInteger)
 }
 
+func (r MonitorSmartDetectorAlertRuleResource) detectorType(data acceptance.TestData, detectorType string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_monitor_smart_detector_alert_rule" "test" {
+  name                = "acctestSDAR-%d"
+  resource_group_name = azurerm_resource_group.test.name
+  severity            = "Sev3"
+  scope_resource_ids  = [azurerm_application_insights.test.id]
+  frequency           = "P1D"
+  detector_type       = "%s"
+
+  action_group {
+    ids = [azurerm_monitor_action_group.test.id]
+  }
+}
+`, r.template(data), data.RandomInteger, detectorType)
+}
+
+func (r MonitorSmartDetectorAlertRuleResource) detectorParameters(data acceptance.TestData, sensitivity string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_monitor_smart_detector_alert_rule" "test" {
+  name                = "acctestSDAR-%d"
+  resource_group_name = azurerm_resource_group.test.name
+  severity            = "Sev0"
+  scope_resource_ids  = [azurerm_application_insights.test.id]
+  frequency           = "PT1M"
+  detector_type       = "FailureAnomaliesDetector"
+
+  detector_parameters = jsonencode({
+    sensitivity = "%s"
+  })
+
+  action_group {
+    ids = [azurerm_monitor_action_group.test.id]
+  }
+}
+`, r.template(data), data.RandomInteger, sensitivity)
+}
+
 func (r MonitorSmartDetectorAlertRuleResource) requiresImport(data acceptance.TestData) string {
 	return fmt.Sprintf(`
 %s
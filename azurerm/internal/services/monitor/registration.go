@@ -21,11 +21,12 @@ func (r Registration) WebsiteCategories() []string {
 // SupportedDataSources returns the supported Data Sources supported by this Service
 func (r Registration) SupportedDataSources() map[string]*schema.Resource {
 	return map[string]*schema.Resource{
-		"azurerm_monitor_action_group":                dataSourceMonitorActionGroup(),
-		"azurerm_monitor_diagnostic_categories":       dataSourceMonitorDiagnosticCategories(),
-		"azurerm_monitor_log_profile":                 dataSourceMonitorLogProfile(),
-		"azurerm_monitor_scheduled_query_rules_alert": dataSourceMonitorScheduledQueryRulesAlert(),
-		"azurerm_monitor_scheduled_query_rules_log":   dataSourceMonitorScheduledQueryRulesLog(),
+		"azurerm_monitor_action_group":                    dataSourceMonitorActionGroup(),
+		"azurerm_monitor_activity_log_alert_arm_template": dataSourceMonitorActivityLogAlertArmTemplate(),
+		"azurerm_monitor_diagnostic_categories":           dataSourceMonitorDiagnosticCategories(),
+		"azurerm_monitor_log_profile":                     dataSourceMonitorLogProfile(),
+		"azurerm_monitor_scheduled_query_rules_alert":     dataSourceMonitorScheduledQueryRulesAlert(),
+		"azurerm_monitor_scheduled_query_rules_log":       dataSourceMonitorScheduledQueryRulesLog(),
 	}
 }
 
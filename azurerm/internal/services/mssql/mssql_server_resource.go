@@ -1,8 +1,10 @@
 package mssql
 
 import (
+	"context"
 	"fmt"
 	"log"
+	"strings"
 	"time"
 
 	"github.com/Azure/azure-sdk-for-go/services/preview/sql/mgmt/v3.0/sql"
@@ -100,6 +102,12 @@ func resourceMsSqlServer() *schema.Resource {
 							Computed:     true,
 							ValidateFunc: validation.IsUUID,
 						},
+
+						"azuread_authentication_only": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  false,
+						},
 					},
 				},
 			},
@@ -183,6 +191,7 @@ func resourceMsSqlServerCreateUpdate(d *schema.ResourceData, meta interface{}) e
 	auditingClient := meta.(*clients.Client).MSSQL.ServerExtendedBlobAuditingPoliciesClient
 	connectionClient := meta.(*clients.Client).MSSQL.ServerConnectionPoliciesClient
 	adminClient := meta.(*clients.Client).MSSQL.ServerAzureADAdministratorsClient
+	aadOnlyAuthenticationsClient := meta.(*clients.Client).MSSQL.ServerAzureADOnlyAuthenticationsClient
 	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -257,23 +266,45 @@ func resourceMsSqlServerCreateUpdate(d *schema.ResourceData, meta interface{}) e
 	d.SetId(*resp.ID)
 
 	if d.HasChange("azuread_administrator") {
-		adminDelFuture, err := adminClient.Delete(ctx, resGroup, name)
-		if err != nil {
-			return fmt.Errorf("deleting SQL Server %q AAD admin (Resource Group %q): %+v", name, resGroup, err)
-		}
-
-		if err = adminDelFuture.WaitForCompletionRef(ctx, adminClient.Client); err != nil {
-			return fmt.Errorf("waiting for SQL Server %q AAD admin (Resource Group %q) to be deleted: %+v", name, resGroup, err)
-		}
+		oldAdmin, newAdmin := d.GetChange("azuread_administrator")
+		if !d.IsNewResource() && msSqlServerAzureADAdministratorUnchanged(oldAdmin.([]interface{}), newAdmin.([]interface{})) {
+			// only `azuread_authentication_only` has changed, so the AAD Administrator itself can be left as-is
+			if err := setMsSqlServerAzureADAuthenticationOnly(ctx, aadOnlyAuthenticationsClient, resGroup, name, msSqlServerAzureADAuthenticationOnly(newAdmin.([]interface{}))); err != nil {
+				return err
+			}
+		} else {
+			// the AAD Administrator can't be removed whilst AAD-only Authentication is enabled, so this needs disabling first
+			if !d.IsNewResource() && msSqlServerAzureADAuthenticationOnly(oldAdmin.([]interface{})) {
+				if err := setMsSqlServerAzureADAuthenticationOnly(ctx, aadOnlyAuthenticationsClient, resGroup, name, false); err != nil {
+					return err
+				}
+			}
 
-		if adminParams := expandMsSqlServerAdministrator(d.Get("azuread_administrator").([]interface{})); adminParams != nil {
-			adminFuture, err := adminClient.CreateOrUpdate(ctx, resGroup, name, *adminParams)
+			adminDelFuture, err := adminClient.Delete(ctx, resGroup, name)
 			if err != nil {
-				return fmt.Errorf("creating SQL Server %q AAD admin (Resource Group %q): %+v", name, resGroup, err)
+				return fmt.Errorf("deleting SQL Server %q AAD admin (Resource Group %q): %+v", name, resGroup, err)
+			}
+
+			if err = adminDelFuture.WaitForCompletionRef(ctx, adminClient.Client); err != nil {
+				return fmt.Errorf("waiting for SQL Server %q AAD admin (Resource Group %q) to be deleted: %+v", name, resGroup, err)
 			}
 
-			if err = adminFuture.WaitForCompletionRef(ctx, adminClient.Client); err != nil {
-				return fmt.Errorf("waiting for creation of SQL Server %q AAD admin (Resource Group %q): %+v", name, resGroup, err)
+			if adminParams := expandMsSqlServerAdministrator(d.Get("azuread_administrator").([]interface{})); adminParams != nil {
+				adminFuture, err := adminClient.CreateOrUpdate(ctx, resGroup, name, *adminParams)
+				if err != nil {
+					return fmt.Errorf("creating SQL Server %q AAD admin (Resource Group %q): %+v", name, resGroup, err)
+				}
+
+				if err = adminFuture.WaitForCompletionRef(ctx, adminClient.Client); err != nil {
+					return fmt.Errorf("waiting for creation of SQL Server %q AAD admin (Resource Group %q): %+v", name, resGroup, err)
+				}
+
+				// AAD-only Authentication can only be enabled once an AAD Administrator exists
+				if msSqlServerAzureADAuthenticationOnly(d.Get("azuread_administrator").([]interface{})) {
+					if err := setMsSqlServerAzureADAuthenticationOnly(ctx, aadOnlyAuthenticationsClient, resGroup, name, true); err != nil {
+						return err
+					}
+				}
 			}
 		}
 	}
@@ -462,8 +493,55 @@ func expandMsSqlServerAdministrator(input []interface{}) *sql.ServerAzureADAdmin
 	return &adminParams
 }
 
+func msSqlServerAzureADAuthenticationOnly(input []interface{}) bool {
+	if len(input) == 0 || input[0] == nil {
+		return false
+	}
+
+	admin := input[0].(map[string]interface{})
+	return admin["azuread_authentication_only"].(bool)
+}
+
+// msSqlServerAzureADAdministratorUnchanged returns whether the AAD Administrator is the same in both blocks,
+// ignoring `azuread_authentication_only` which is managed separately
+func msSqlServerAzureADAdministratorUnchanged(old, new []interface{}) bool {
+	if len(old) == 0 || old[0] == nil || len(new) == 0 || new[0] == nil {
+		return false
+	}
+
+	oldAdmin := old[0].(map[string]interface{})
+	newAdmin := new[0].(map[string]interface{})
+	for _, key := range []string{"login_username", "object_id", "tenant_id"} {
+		if !strings.EqualFold(oldAdmin[key].(string), newAdmin[key].(string)) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func setMsSqlServerAzureADAuthenticationOnly(ctx context.Context, client *sql.ServerAzureADOnlyAuthenticationsClient, resourceGroup, serverName string, enabled bool) error {
+	parameters := sql.ServerAzureADOnlyAuthentication{
+		AzureADOnlyAuthProperties: &sql.AzureADOnlyAuthProperties{
+			AzureADOnlyAuthentication: utils.Bool(enabled),
+		},
+	}
+
+	future, err := client.CreateOrUpdate(ctx, resourceGroup, serverName, parameters)
+	if err != nil {
+		return fmt.Errorf("setting AAD-only Authentication for SQL Server %q (Resource Group %q): %+v", serverName, resourceGroup, err)
+	}
+
+	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("waiting for AAD-only Authentication to be set for SQL Server %q (Resource Group %q): %+v", serverName, resourceGroup, err)
+	}
+
+	return nil
+}
+
 func flatternMsSqlServerAdministrator(admin sql.ServerAzureADAdministrator) []interface{} {
 	var login, sid, tid string
+	var aadOnlyAuthentication bool
 	if admin.Login != nil {
 		login = *admin.Login
 	}
@@ -476,11 +554,16 @@ func flatternMsSqlServerAdministrator(admin sql.ServerAzureADAdministrator) []in
 		tid = admin.TenantID.String()
 	}
 
+	if admin.AzureADOnlyAuthentication != nil {
+		aadOnlyAuthentication = *admin.AzureADOnlyAuthentication
+	}
+
 	return []interface{}{
 		map[string]interface{}{
-			"login_username": login,
-			"object_id":      sid,
-			"tenant_id":      tid,
+			"login_username":              login,
+			"object_id":                   sid,
+			"tenant_id":                   tid,
+			"azuread_authentication_only": aadOnlyAuthentication,
 		},
 	}
 }
@@ -1,6 +1,7 @@
 package network
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"strings"
@@ -13,6 +14,8 @@ import (
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/clients"
+	keyVaultClient "github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/keyvault/client"
+	keyVaultParse "github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/keyvault/parse"
 	keyVaultValidate "github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/keyvault/validate"
 	msiParse "github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/msi/parse"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/network/parse"
@@ -1030,6 +1033,11 @@ func resourceApplicationGateway() *schema.Resource {
 							ValidateFunc: keyVaultValidate.NestedItemIdWithOptionalVersion,
 						},
 
+						"observed_version": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
 						"id": {
 							Type:     schema.TypeString,
 							Computed: true,
@@ -1494,6 +1502,7 @@ func resourceApplicationGatewayCreateUpdate(d *schema.ResourceData, meta interfa
 
 func resourceApplicationGatewayRead(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*clients.Client).Network.ApplicationGatewaysClient
+	keyVaultsClient := meta.(*clients.Client).KeyVault
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -1608,7 +1617,11 @@ func resourceApplicationGatewayRead(d *schema.ResourceData, meta interface{}) er
 			return fmt.Errorf("Error setting `autoscale_configuration`: %+v", setErr)
 		}
 
-		if setErr := d.Set("ssl_certificate", flattenApplicationGatewaySslCertificates(props.SslCertificates, d)); setErr != nil {
+		sslCertificates, err := flattenApplicationGatewaySslCertificates(ctx, keyVaultsClient, props.SslCertificates, d)
+		if err != nil {
+			return fmt.Errorf("Error flattening `ssl_certificate`: %+v", err)
+		}
+		if setErr := d.Set("ssl_certificate", sslCertificates); setErr != nil {
 			return fmt.Errorf("Error setting `ssl_certificate`: %+v", setErr)
 		}
 
@@ -3278,12 +3291,15 @@ func expandApplicationGatewaySslCertificates(d *schema.ResourceData) (*[]network
 	return &results, nil
 }
 
-func flattenApplicationGatewaySslCertificates(input *[]network.ApplicationGatewaySslCertificate, d *schema.ResourceData) []interface{} {
+func flattenApplicationGatewaySslCertificates(ctx context.Context, keyVaultsClient *keyVaultClient.Client, input *[]network.ApplicationGatewaySslCertificate, d *schema.ResourceData) ([]interface{}, error) {
 	results := make([]interface{}, 0)
 	if input == nil {
-		return results
+		return results, nil
 	}
 
+	// multiple SSL Certificates can reference the same Secret, so only look each one up once
+	observedVersions := make(map[string]string)
+
 	for _, v := range *input {
 		output := map[string]interface{}{}
 		if v.Name == nil {
@@ -3305,6 +3321,16 @@ func flattenApplicationGatewaySslCertificates(input *[]network.ApplicationGatewa
 
 			if kvsid := props.KeyVaultSecretID; kvsid != nil {
 				output["key_vault_secret_id"] = *kvsid
+				observedVersion, ok := observedVersions[*kvsid]
+				if !ok {
+					version, err := flattenApplicationGatewaySslCertificateObservedVersion(ctx, keyVaultsClient, *kvsid)
+					if err != nil {
+						return nil, err
+					}
+					observedVersion = version
+					observedVersions[*kvsid] = version
+				}
+				output["observed_version"] = observedVersion
 			}
 		}
 
@@ -3331,7 +3357,43 @@ func flattenApplicationGatewaySslCertificates(input *[]network.ApplicationGatewa
 		results = append(results, output)
 	}
 
-	return results
+	return results, nil
+}
+
+// flattenApplicationGatewaySslCertificateObservedVersion returns the version of the Key Vault Secret which
+// the SSL Certificate is currently using, resolving versionless IDs so that rotations surface as a diff
+func flattenApplicationGatewaySslCertificateObservedVersion(ctx context.Context, keyVaultsClient *keyVaultClient.Client, secretId string) (string, error) {
+	id, err := keyVaultParse.ParseOptionallyVersionedNestedItemID(secretId)
+	if err != nil {
+		return "", fmt.Errorf("parsing Key Vault Secret ID %q: %+v", secretId, err)
+	}
+
+	if id.Version != "" {
+		return id.Version, nil
+	}
+
+	// the Application Gateway reads the Secret using its own identity, so the credentials used by Terraform
+	// may not have access to it (or it may have since been deleted) - in which case the version is unknown
+	resp, err := keyVaultsClient.ManagementClient.GetSecret(ctx, id.KeyVaultBaseUrl, id.Name, "")
+	if err != nil {
+		if utils.ResponseWasForbidden(resp.Response) || utils.ResponseWasNotFound(resp.Response) {
+			log.Printf("[WARN] Unable to determine the current version of Key Vault Secret %q - leaving `observed_version` empty: %+v", secretId, err)
+			return "", nil
+		}
+
+		return "", fmt.Errorf("retrieving Key Vault Secret %q: %+v", secretId, err)
+	}
+
+	if resp.ID == nil {
+		return "", fmt.Errorf("retrieving Key Vault Secret %q: `id` was nil", secretId)
+	}
+
+	current, err := keyVaultParse.ParseNestedItemID(*resp.ID)
+	if err != nil {
+		return "", fmt.Errorf("parsing %q: %+v", *resp.ID, err)
+	}
+
+	return current.Version, nil
 }
 
 func expandApplicationGatewayURLPathMaps(d *schema.ResourceData, gatewayID string) (*[]network.ApplicationGatewayURLPathMap, error) {
@@ -511,6 +511,9 @@ func TestAccApplicationGateway_sslCertificate_keyvault_versionless(t *testing.T)
 			Check: resource.ComposeTestCheckFunc(
 				check.That(data.ResourceName).ExistsInAzure(r),
 				check.That(data.ResourceName).Key("ssl_certificate.0.key_vault_secret_id").Exists(),
+				check.That(data.ResourceName).Key("ssl_certificate.0.observed_version").MatchesOtherKey(
+					check.That("azurerm_key_vault_certificate.test").Key("version"),
+				),
 			),
 		},
 		data.ImportStep(),
@@ -527,6 +530,9 @@ func TestAccApplicationGateway_sslCertificate_keyvault_versioned(t *testing.T) {
 			Check: resource.ComposeTestCheckFunc(
 				check.That(data.ResourceName).ExistsInAzure(r),
 				check.That(data.ResourceName).Key("ssl_certificate.0.key_vault_secret_id").Exists(),
+				check.That(data.ResourceName).Key("ssl_certificate.0.observed_version").MatchesOtherKey(
+					check.That("azurerm_key_vault_certificate.test").Key("version"),
+				),
 			),
 		},
 		data.ImportStep(),
@@ -88,7 +88,7 @@ func resourceNatGateway() *schema.Resource {
 				}, false),
 			},
 
-			"zones": azure.SchemaZones(),
+			"zones": azure.SchemaSingleZone(),
 
 			"resource_guid": {
 				Type:     schema.TypeString,
@@ -2,6 +2,7 @@ package network
 
 import (
 	"fmt"
+	"strings"
 	"time"
 
 	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2020-05-01/network"
@@ -23,6 +24,10 @@ import (
 
 var networkSecurityGroupResourceName = "azurerm_network_security_group"
 
+// the maximum number of Application Security Groups which can be referenced across the source and destination
+// Application Security Groups of all of the Security Rules within a single Network Security Group
+const networkSecurityGroupMaxApplicationSecurityGroups = 100
+
 func resourceNetworkSecurityGroup() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceNetworkSecurityGroupCreateUpdate,
@@ -42,6 +47,8 @@ func resourceNetworkSecurityGroup() *schema.Resource {
 			Delete: schema.DefaultTimeout(30 * time.Minute),
 		},
 
+		CustomizeDiff: resourceNetworkSecurityGroupCustomizeDiff,
+
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Type:     schema.TypeString,
@@ -299,6 +306,47 @@ func resourceNetworkSecurityGroupDelete(d *schema.ResourceData, meta interface{}
 	return err
 }
 
+func resourceNetworkSecurityGroupCustomizeDiff(diff *schema.ResourceDiff, _ interface{}) error {
+	rules, ok := diff.Get("security_rule").(*schema.Set)
+	if !ok || rules == nil {
+		return nil
+	}
+
+	return validateNetworkSecurityGroupApplicationSecurityGroups(rules.List())
+}
+
+// validateNetworkSecurityGroupApplicationSecurityGroups ensures that the Security Rules don't reference more
+// Application Security Groups than the API allows - which otherwise is only surfaced once the apply fails
+func validateNetworkSecurityGroupApplicationSecurityGroups(rules []interface{}) error {
+	applicationSecurityGroupIds := make(map[string]struct{})
+
+	for _, raw := range rules {
+		rule, ok := raw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		for _, key := range []string{"source_application_security_group_ids", "destination_application_security_group_ids"} {
+			ids, ok := rule[key].(*schema.Set)
+			if !ok {
+				continue
+			}
+
+			for _, id := range ids.List() {
+				if v, ok := id.(string); ok && v != "" {
+					applicationSecurityGroupIds[strings.ToLower(v)] = struct{}{}
+				}
+			}
+		}
+	}
+
+	if count := len(applicationSecurityGroupIds); count > networkSecurityGroupMaxApplicationSecurityGroups {
+		return fmt.Errorf("a maximum of %d Application Security Groups can be referenced across all `security_rule` blocks within a Network Security Group but %d were specified", networkSecurityGroupMaxApplicationSecurityGroups, count)
+	}
+
+	return nil
+}
+
 func expandAzureRmSecurityRules(d *schema.ResourceData) ([]network.SecurityRule, error) {
 	sgRules := d.Get("security_rule").(*schema.Set).List()
 	rules := make([]network.SecurityRule, 0)
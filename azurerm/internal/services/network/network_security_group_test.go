@@ -0,0 +1,97 @@
+package network
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+)
+
+func TestValidateNetworkSecurityGroupApplicationSecurityGroups(t *testing.T) {
+	applicationSecurityGroupIds := func(start, count int) *schema.Set {
+		ids := make([]interface{}, 0)
+		for i := start; i < start+count; i++ {
+			ids = append(ids, fmt.Sprintf("/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.Network/applicationSecurityGroups/asg%d", i))
+		}
+		return schema.NewSet(schema.HashString, ids)
+	}
+	rule := func(source, destination *schema.Set) interface{} {
+		return map[string]interface{}{
+			"source_application_security_group_ids":      source,
+			"destination_application_security_group_ids": destination,
+		}
+	}
+	upperCased := func(input *schema.Set) *schema.Set {
+		ids := make([]interface{}, 0)
+		for _, id := range input.List() {
+			ids = append(ids, strings.ToUpper(id.(string)))
+		}
+		return schema.NewSet(schema.HashString, ids)
+	}
+
+	testData := []struct {
+		Name  string
+		Rules []interface{}
+		Error bool
+	}{
+		{
+			Name:  "No Rules",
+			Rules: []interface{}{},
+			Error: false,
+		},
+		{
+			Name: "No Application Security Groups",
+			Rules: []interface{}{
+				rule(applicationSecurityGroupIds(0, 0), applicationSecurityGroupIds(0, 0)),
+			},
+			Error: false,
+		},
+		{
+			Name: "At the Limit",
+			Rules: []interface{}{
+				rule(applicationSecurityGroupIds(0, 50), applicationSecurityGroupIds(50, 50)),
+			},
+			Error: false,
+		},
+		{
+			Name: "Over the Limit in a Single Rule",
+			Rules: []interface{}{
+				rule(applicationSecurityGroupIds(0, 101), applicationSecurityGroupIds(0, 0)),
+			},
+			Error: true,
+		},
+		{
+			Name: "Over the Limit across Rules",
+			Rules: []interface{}{
+				rule(applicationSecurityGroupIds(0, 60), applicationSecurityGroupIds(0, 0)),
+				rule(applicationSecurityGroupIds(0, 0), applicationSecurityGroupIds(60, 41)),
+			},
+			Error: true,
+		},
+		{
+			Name: "Duplicates across Rules are Counted Once",
+			Rules: []interface{}{
+				rule(applicationSecurityGroupIds(0, 100), applicationSecurityGroupIds(0, 100)),
+				rule(applicationSecurityGroupIds(0, 100), applicationSecurityGroupIds(0, 0)),
+			},
+			Error: false,
+		},
+		{
+			Name: "Duplicates are Case Insensitive",
+			Rules: []interface{}{
+				rule(applicationSecurityGroupIds(0, 100), upperCased(applicationSecurityGroupIds(0, 100))),
+			},
+			Error: false,
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q..", v.Name)
+
+		err := validateNetworkSecurityGroupApplicationSecurityGroups(v.Rules)
+		if v.Error != (err != nil) {
+			t.Fatalf("Expected an error to be %t but got: %+v", v.Error, err)
+		}
+	}
+}
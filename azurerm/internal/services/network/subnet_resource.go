@@ -41,6 +41,8 @@ func resourceSubnet() *schema.Resource {
 			Delete: schema.DefaultTimeout(30 * time.Minute),
 		},
 
+		CustomizeDiff: resourceSubnetCustomizeDiff,
+
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Type:     schema.TypeString,
@@ -446,6 +448,78 @@ func flattenSubnetServiceEndpoints(serviceEndpoints *[]network.ServiceEndpointPr
 	return endpoints
 }
 
+// resourceSubnetCustomizeDiff validates the combination of `delegation` and `service_endpoints` blocks at plan
+// time, since these are otherwise only surfaced once the API returns a 400 during the apply
+func resourceSubnetCustomizeDiff(diff *schema.ResourceDiff, _ interface{}) error {
+	if diff.NewValueKnown("delegation") {
+		if err := validateSubnetDelegations(diff.Get("delegation").([]interface{})); err != nil {
+			return err
+		}
+	}
+
+	if diff.NewValueKnown("service_endpoints") {
+		if err := validateSubnetServiceEndpoints(diff.Get("service_endpoints").([]interface{})); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func validateSubnetDelegations(input []interface{}) error {
+	names := make(map[string]struct{})
+	services := make(map[string]string)
+
+	for _, raw := range input {
+		delegation, ok := raw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		name := delegation["name"].(string)
+		if name != "" {
+			if _, exists := names[strings.ToLower(name)]; exists {
+				return fmt.Errorf("the `delegation` %q is specified multiple times - the name of each `delegation` must be unique within the Subnet", name)
+			}
+			names[strings.ToLower(name)] = struct{}{}
+		}
+
+		serviceDelegations, ok := delegation["service_delegation"].([]interface{})
+		if !ok || len(serviceDelegations) == 0 || serviceDelegations[0] == nil {
+			continue
+		}
+		serviceName := serviceDelegations[0].(map[string]interface{})["name"].(string)
+		if serviceName == "" {
+			continue
+		}
+
+		if existing, exists := services[strings.ToLower(serviceName)]; exists {
+			return fmt.Errorf("the Subnet can only be delegated to %q once but this is specified in both the `delegation` %q and %q", serviceName, existing, name)
+		}
+		services[strings.ToLower(serviceName)] = name
+	}
+
+	return nil
+}
+
+func validateSubnetServiceEndpoints(input []interface{}) error {
+	serviceEndpoints := make(map[string]struct{})
+
+	for _, raw := range input {
+		serviceEndpoint, ok := raw.(string)
+		if !ok || serviceEndpoint == "" {
+			continue
+		}
+
+		if _, exists := serviceEndpoints[strings.ToLower(serviceEndpoint)]; exists {
+			return fmt.Errorf("the Service Endpoint %q is specified multiple times within `service_endpoints`", serviceEndpoint)
+		}
+		serviceEndpoints[strings.ToLower(serviceEndpoint)] = struct{}{}
+	}
+
+	return nil
+}
+
 func expandSubnetDelegation(input []interface{}) *[]network.Delegation {
 	retDelegations := make([]network.Delegation, 0)
 
@@ -3,6 +3,7 @@ package network_test
 import (
 	"context"
 	"fmt"
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
@@ -113,6 +114,18 @@ func TestAccSubnet_delegation(t *testing.T) {
 	})
 }
 
+func TestAccSubnet_delegationDuplicateService(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_subnet", "test")
+	r := SubnetResource{}
+
+	data.ResourceTest(t, r, []resource.TestStep{
+		{
+			Config:      r.delegationDuplicateService(data),
+			ExpectError: regexp.MustCompile("the Subnet can only be delegated to \"Microsoft.ContainerInstance/containerGroups\" once"),
+		},
+	})
+}
+
 func TestAccSubnet_enforcePrivateLinkEndpointNetworkPolicies(t *testing.T) {
 	data := acceptance.BuildTestData(t, "azurerm_subnet", "test")
 	r := SubnetResource{}
@@ -406,6 +419,35 @@ resource "azurerm_subnet" "test" {
 `, r.template(data))
 }
 
+func (r SubnetResource) delegationDuplicateService(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_subnet" "test" {
+  name                 = "internal"
+  resource_group_name  = azurerm_resource_group.test.name
+  virtual_network_name = azurerm_virtual_network.test.name
+  address_prefix       = "10.0.2.0/24"
+
+  delegation {
+    name = "first"
+
+    service_delegation {
+      name = "Microsoft.ContainerInstance/containerGroups"
+    }
+  }
+
+  delegation {
+    name = "second"
+
+    service_delegation {
+      name = "Microsoft.ContainerInstance/containerGroups"
+    }
+  }
+}
+`, r.template(data))
+}
+
 func (r SubnetResource) delegationUpdated(data acceptance.TestData) string {
 	return fmt.Sprintf(`
 %s
@@ -0,0 +1,250 @@
+package network
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"sort"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2020-07-01/network"
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/azure"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/clients"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/network/parse"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/network/validate"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/timeouts"
+)
+
+func dataSourceVirtualHubBgpConnectionRoutes() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceVirtualHubBgpConnectionRoutesRead,
+
+		Timeouts: &schema.ResourceTimeout{
+			Read: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"virtual_hub_bgp_connection_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validate.BgpConnectionID,
+			},
+
+			"learned_route": dataSourceVirtualHubBgpConnectionRoutesSchema(),
+
+			"advertised_route": dataSourceVirtualHubBgpConnectionRoutesSchema(),
+		},
+	}
+}
+
+func dataSourceVirtualHubBgpConnectionRoutesSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Computed: true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"network": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+
+				"next_hop": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+
+				"as_path": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+
+				"origin": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+
+				"source_peer": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+
+				"local_address": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+
+				"weight": {
+					Type:     schema.TypeInt,
+					Computed: true,
+				},
+			},
+		},
+	}
+}
+
+func dataSourceVirtualHubBgpConnectionRoutesRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Network.VirtualHubBgpConnectionsClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.BgpConnectionID(d.Get("virtual_hub_bgp_connection_id").(string))
+	if err != nil {
+		return err
+	}
+
+	learnedFuture, err := client.ListLearnedRoutes(ctx, id.ResourceGroup, id.VirtualHubName, id.Name)
+	if err != nil {
+		return fmt.Errorf("listing Learned Routes for %s: %+v", id, err)
+	}
+	learned, err := virtualHubBgpConnectionRoutesResult(ctx, client, learnedFuture.FutureAPI)
+	if err != nil {
+		return fmt.Errorf("retrieving Learned Routes for %s: %+v", id, err)
+	}
+
+	advertisedFuture, err := client.ListAdvertisedRoutes(ctx, id.ResourceGroup, id.VirtualHubName, id.Name)
+	if err != nil {
+		return fmt.Errorf("listing Advertised Routes for %s: %+v", id, err)
+	}
+	advertised, err := virtualHubBgpConnectionRoutesResult(ctx, client, advertisedFuture.FutureAPI)
+	if err != nil {
+		return fmt.Errorf("retrieving Advertised Routes for %s: %+v", id, err)
+	}
+
+	d.SetId(id.ID())
+	d.Set("virtual_hub_bgp_connection_id", id.ID())
+
+	if err := d.Set("learned_route", flattenVirtualHubBgpConnectionRoutes(learned)); err != nil {
+		return fmt.Errorf("setting `learned_route`: %+v", err)
+	}
+
+	if err := d.Set("advertised_route", flattenVirtualHubBgpConnectionRoutes(advertised)); err != nil {
+		return fmt.Errorf("setting `advertised_route`: %+v", err)
+	}
+
+	return nil
+}
+
+// virtualHubBgpConnectionRoutesResult waits for the Learned/Advertised Routes operation to complete and returns the
+// routes - the SDK can't be used for this since the API returns the routes keyed by the instance of the Route Service
+// (e.g. `RouteServiceRole_IN_0`) rather than within `value`, as such the response is parsed directly
+func virtualHubBgpConnectionRoutesResult(ctx context.Context, client *network.VirtualHubBgpConnectionsClient, future azure.FutureAPI) ([]network.PeerRoute, error) {
+	if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return nil, fmt.Errorf("waiting for completion: %+v", err)
+	}
+
+	sender := autorest.DecorateSender(client, autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
+	resp, err := future.GetResult(sender)
+	if err != nil {
+		return nil, err
+	}
+	if resp == nil {
+		return nil, fmt.Errorf("received a nil response")
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusNoContent {
+		return []network.PeerRoute{}, nil
+	}
+	if resp.StatusCode != http.StatusOK && resp.StatusCode != http.StatusAccepted {
+		return nil, fmt.Errorf("unexpected status code %d", resp.StatusCode)
+	}
+
+	body, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("reading response body: %+v", err)
+	}
+
+	return parseVirtualHubBgpConnectionRoutes(body)
+}
+
+// parseVirtualHubBgpConnectionRoutes parses the routes from each instance of the Route Service, in the order of the
+// instance names - `value` is also supported should the API return the documented `PeerRouteList` shape
+func parseVirtualHubBgpConnectionRoutes(input []byte) ([]network.PeerRoute, error) {
+	results := make([]network.PeerRoute, 0)
+	if len(input) == 0 {
+		return results, nil
+	}
+
+	var instances map[string]json.RawMessage
+	if err := json.Unmarshal(input, &instances); err != nil {
+		return nil, fmt.Errorf("parsing routes: %+v", err)
+	}
+
+	names := make([]string, 0)
+	for name := range instances {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		raw := instances[name]
+		if string(raw) == "null" {
+			continue
+		}
+
+		var routes []network.PeerRoute
+		if err := json.Unmarshal(raw, &routes); err != nil {
+			return nil, fmt.Errorf("parsing routes for %q: %+v", name, err)
+		}
+		results = append(results, routes...)
+	}
+
+	return results, nil
+}
+
+func flattenVirtualHubBgpConnectionRoutes(input []network.PeerRoute) []interface{} {
+	results := make([]interface{}, 0)
+
+	for _, item := range input {
+		networkPrefix := ""
+		if item.NetworkProperty != nil {
+			networkPrefix = *item.NetworkProperty
+		}
+
+		nextHop := ""
+		if item.NextHop != nil {
+			nextHop = *item.NextHop
+		}
+
+		asPath := ""
+		if item.AsPath != nil {
+			asPath = *item.AsPath
+		}
+
+		origin := ""
+		if item.Origin != nil {
+			origin = *item.Origin
+		}
+
+		sourcePeer := ""
+		if item.SourcePeer != nil {
+			sourcePeer = *item.SourcePeer
+		}
+
+		localAddress := ""
+		if item.LocalAddress != nil {
+			localAddress = *item.LocalAddress
+		}
+
+		weight := 0
+		if item.Weight != nil {
+			weight = int(*item.Weight)
+		}
+
+		results = append(results, map[string]interface{}{
+			"network":       networkPrefix,
+			"next_hop":      nextHop,
+			"as_path":       asPath,
+			"origin":        origin,
+			"source_peer":   sourcePeer,
+			"local_address": localAddress,
+			"weight":        weight,
+		})
+	}
+
+	return results
+}
@@ -0,0 +1,41 @@
+package network_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/acceptance"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/acceptance/check"
+)
+
+type VirtualHubBgpConnectionRoutesDataSource struct {
+}
+
+func TestAccDataSourceVirtualHubBgpConnectionRoutes_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azurerm_virtual_hub_bgp_connection_routes", "test")
+	r := VirtualHubBgpConnectionRoutesDataSource{}
+
+	data.DataSourceTest(t, []resource.TestStep{
+		{
+			Config: r.basic(data),
+			Check: resource.ComposeTestCheckFunc(
+				// there's no BGP speaker at the peer's IP address, so no routes are learned
+				check.That(data.ResourceName).Key("learned_route.#").Exists(),
+				check.That(data.ResourceName).Key("advertised_route.#").MatchesRegex(regexp.MustCompile(`^[1-9][0-9]*$`)),
+				check.That(data.ResourceName).Key("advertised_route.0.network").Exists(),
+			),
+		},
+	})
+}
+
+func (VirtualHubBgpConnectionRoutesDataSource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+data "azurerm_virtual_hub_bgp_connection_routes" "test" {
+  virtual_hub_bgp_connection_id = azurerm_virtual_hub_bgp_connection.test.id
+}
+`, VirtualHubBGPConnectionResource{}.basic(data))
+}
@@ -0,0 +1,83 @@
+package network
+
+import (
+	"testing"
+)
+
+func TestParseVirtualHubBgpConnectionRoutes(t *testing.T) {
+	testData := []struct {
+		name             string
+		input            string
+		expectError      bool
+		expectedNetworks []string
+	}{
+		{
+			name:             "empty",
+			input:            "",
+			expectedNetworks: []string{},
+		},
+		{
+			name:        "not json",
+			input:       "hello",
+			expectError: true,
+		},
+		{
+			name:             "no instances",
+			input:            `{}`,
+			expectedNetworks: []string{},
+		},
+		{
+			name: "per instance",
+			input: `{
+  "RouteServiceRole_IN_1": [
+    {"localAddress": "10.5.1.19", "network": "10.5.0.0/16", "nextHop": "10.5.1.19", "sourcePeer": "10.5.1.19", "origin": "Igp", "asPath": "65515", "weight": 0}
+  ],
+  "RouteServiceRole_IN_0": [
+    {"localAddress": "10.5.1.18", "network": "10.5.0.0/16", "nextHop": "10.5.1.18", "sourcePeer": "10.5.1.18", "origin": "Igp", "asPath": "65515", "weight": 0},
+    {"localAddress": "10.5.1.18", "network": "10.6.0.0/16", "nextHop": "10.5.1.18", "sourcePeer": "10.5.1.18", "origin": "Igp", "asPath": "65515", "weight": 0}
+  ]
+}`,
+			expectedNetworks: []string{"10.5.0.0/16", "10.6.0.0/16", "10.5.0.0/16"},
+		},
+		{
+			name:             "instance without routes",
+			input:            `{"RouteServiceRole_IN_0": null, "RouteServiceRole_IN_1": []}`,
+			expectedNetworks: []string{},
+		},
+		{
+			name:             "value",
+			input:            `{"value": [{"network": "10.5.0.0/16"}]}`,
+			expectedNetworks: []string{"10.5.0.0/16"},
+		},
+		{
+			name:        "instance isn't a list",
+			input:       `{"RouteServiceRole_IN_0": "hello"}`,
+			expectError: true,
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q..", v.name)
+
+		actual, err := parseVirtualHubBgpConnectionRoutes([]byte(v.input))
+		if err != nil {
+			if v.expectError {
+				continue
+			}
+
+			t.Fatalf("expected no error but got: %+v", err)
+		}
+		if v.expectError {
+			t.Fatalf("expected an error but didn't get one")
+		}
+
+		if len(actual) != len(v.expectedNetworks) {
+			t.Fatalf("expected %d routes but got %d", len(v.expectedNetworks), len(actual))
+		}
+		for i, route := range actual {
+			if route.NetworkProperty == nil || *route.NetworkProperty != v.expectedNetworks[i] {
+				t.Fatalf("expected route %d to be for %q but got %+v", i, v.expectedNetworks[i], route.NetworkProperty)
+			}
+		}
+	}
+}
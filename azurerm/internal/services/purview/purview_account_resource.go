@@ -102,6 +102,27 @@ func resourcePurviewAccount() *schema.Resource {
 				Computed: true,
 			},
 
+			"managed_resources": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"event_hub_namespace_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"resource_group_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"storage_account_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+
 			"atlas_kafka_endpoint_primary_connection_string": {
 				Type:      schema.TypeString,
 				Computed:  true,
@@ -208,6 +229,10 @@ func resourcePurviewAccountRead(d *schema.ResourceData, meta interface{}) error
 			d.Set("guardian_endpoint", endpoints.Guardian)
 			d.Set("scan_endpoint", endpoints.Scan)
 		}
+
+		if err := d.Set("managed_resources", flattenPurviewAccountManagedResources(props.ManagedResources)); err != nil {
+			return fmt.Errorf("flattening `managed_resources`: %+v", err)
+		}
 	}
 
 	keys, err := client.ListKeys(ctx, id.ResourceGroup, id.Name)
@@ -288,3 +313,32 @@ func flattenPurviewAccountIdentity(identity *purview.Identity) interface{} {
 		},
 	}
 }
+
+func flattenPurviewAccountManagedResources(input *purview.AccountPropertiesManagedResources) []interface{} {
+	if input == nil {
+		return make([]interface{}, 0)
+	}
+
+	eventHubNamespaceId := ""
+	if input.EventHubNamespace != nil {
+		eventHubNamespaceId = *input.EventHubNamespace
+	}
+
+	resourceGroupId := ""
+	if input.ResourceGroup != nil {
+		resourceGroupId = *input.ResourceGroup
+	}
+
+	storageAccountId := ""
+	if input.StorageAccount != nil {
+		storageAccountId = *input.StorageAccount
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"event_hub_namespace_id": eventHubNamespaceId,
+			"resource_group_id":      resourceGroupId,
+			"storage_account_id":     storageAccountId,
+		},
+	}
+}
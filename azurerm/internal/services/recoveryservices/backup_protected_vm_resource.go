@@ -8,6 +8,7 @@ import (
 	"time"
 
 	"github.com/Azure/azure-sdk-for-go/services/recoveryservices/mgmt/2019-05-13/backup"
+	"github.com/Azure/go-autorest/autorest"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
@@ -117,8 +118,25 @@ func resourceRecoveryServicesBackupProtectedVMCreateUpdate(d *schema.ResourceDat
 		},
 	}
 
-	if _, err = client.CreateOrUpdate(ctx, vaultName, resourceGroup, "Azure", containerName, protectedItemName, item); err != nil {
-		return fmt.Errorf("Error creating/updating Azure Backup Protected VM %q (Resource Group %q): %+v", protectedItemName, resourceGroup, err)
+	// the Recovery Services Vault only allows a single protection operation at a time, so when protecting
+	// multiple VMs in parallel we need to retry until any in-progress operations have completed
+	timeout := d.Timeout(schema.TimeoutCreate)
+	if !d.IsNewResource() {
+		timeout = d.Timeout(schema.TimeoutUpdate)
+	}
+	err = resource.Retry(timeout, func() *resource.RetryError {
+		resp, err := client.CreateOrUpdate(ctx, vaultName, resourceGroup, "Azure", containerName, protectedItemName, item)
+		if err != nil {
+			if backupOperationInProgress(resp.Response, err) {
+				return resource.RetryableError(fmt.Errorf("waiting for an in-progress operation on Recovery Services Vault %q (Resource Group %q) to complete: %+v", vaultName, resourceGroup, err))
+			}
+			return resource.NonRetryableError(fmt.Errorf("Error creating/updating Azure Backup Protected VM %q (Resource Group %q): %+v", protectedItemName, resourceGroup, err))
+		}
+
+		return nil
+	})
+	if err != nil {
+		return err
 	}
 
 	resp, err := resourceRecoveryServicesBackupProtectedVMWaitForStateCreateUpdate(ctx, client, vaultName, resourceGroup, containerName, protectedItemName, policyId, d)
@@ -192,11 +210,22 @@ func resourceRecoveryServicesBackupProtectedVMDelete(d *schema.ResourceData, met
 
 	log.Printf("[DEBUG] Deleting Azure Backup Protected Item %q (resource group %q)", protectedItemName, resourceGroup)
 
-	resp, err := client.Delete(ctx, vaultName, resourceGroup, "Azure", containerName, protectedItemName)
-	if err != nil {
-		if !utils.ResponseWasNotFound(resp) {
-			return fmt.Errorf("Error issuing delete request for Azure Backup Protected VM %q (Resource Group %q): %+v", protectedItemName, resourceGroup, err)
+	err = resource.Retry(d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
+		resp, err := client.Delete(ctx, vaultName, resourceGroup, "Azure", containerName, protectedItemName)
+		if err != nil {
+			if utils.ResponseWasNotFound(resp) {
+				return nil
+			}
+			if backupOperationInProgress(resp, err) {
+				return resource.RetryableError(fmt.Errorf("waiting for an in-progress operation on Recovery Services Vault %q (Resource Group %q) to complete: %+v", vaultName, resourceGroup, err))
+			}
+			return resource.NonRetryableError(fmt.Errorf("Error issuing delete request for Azure Backup Protected VM %q (Resource Group %q): %+v", protectedItemName, resourceGroup, err))
 		}
+
+		return nil
+	})
+	if err != nil {
+		return err
 	}
 
 	if _, err := resourceRecoveryServicesBackupProtectedVMWaitForDeletion(ctx, client, vaultName, resourceGroup, containerName, protectedItemName, "", d); err != nil {
@@ -212,7 +241,8 @@ func resourceRecoveryServicesBackupProtectedVMWaitForStateCreateUpdate(ctx conte
 		Delay:      10 * time.Second,
 		Pending:    []string{"NotFound"},
 		Target:     []string{"Found"},
-		Refresh:    resourceRecoveryServicesBackupProtectedVMRefreshFunc(ctx, client, vaultName, resourceGroup, containerName, protectedItemName, policyId, true),
+		// when switching to a different Backup Policy we need to wait for the new policy to be applied
+		Refresh: resourceRecoveryServicesBackupProtectedVMRefreshFunc(ctx, client, vaultName, resourceGroup, containerName, protectedItemName, policyId, d.IsNewResource()),
 	}
 
 	if d.IsNewResource() {
@@ -263,7 +293,7 @@ func resourceRecoveryServicesBackupProtectedVMRefreshFunc(ctx context.Context, c
 			if properties := resp.Properties; properties != nil {
 				if vm, ok := properties.AsAzureIaaSComputeVMProtectedItem(); ok {
 					if v := vm.PolicyID; v != nil {
-						if strings.Replace(*v, "Subscriptions", "subscriptions", 1) != policyId {
+						if !strings.EqualFold(*v, policyId) {
 							return resp, "NotFound", nil
 						}
 					} else {
@@ -279,3 +309,17 @@ func resourceRecoveryServicesBackupProtectedVMRefreshFunc(ctx context.Context, c
 		return resp, "Found", nil
 	}
 }
+
+// backupOperationInProgress returns whether the request failed because another operation is in progress for the
+// Recovery Services Vault / Protected Item, in which case it's safe to retry once that operation has completed
+func backupOperationInProgress(resp autorest.Response, err error) bool {
+	if err == nil {
+		return false
+	}
+
+	if utils.ResponseWasConflict(resp) {
+		return true
+	}
+
+	return strings.Contains(err.Error(), "AnotherOperationInProgress") || strings.Contains(err.Error(), "ConcurrentOperation")
+}
@@ -0,0 +1,70 @@
+package recoveryservices
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/Azure/go-autorest/autorest"
+)
+
+func TestBackupOperationInProgress(t *testing.T) {
+	testData := []struct {
+		Name       string
+		StatusCode int
+		Error      error
+		Expected   bool
+	}{
+		{
+			Name:       "No Error",
+			StatusCode: http.StatusOK,
+			Error:      nil,
+			Expected:   false,
+		},
+		{
+			Name:       "Conflict",
+			StatusCode: http.StatusConflict,
+			Error:      fmt.Errorf("conflict"),
+			Expected:   true,
+		},
+		{
+			Name:       "Another Operation In Progress",
+			StatusCode: http.StatusBadRequest,
+			Error:      fmt.Errorf(`Code="AnotherOperationInProgress" Message="Another operation is in progress on the selected item."`),
+			Expected:   true,
+		},
+		{
+			Name:       "Concurrent Operation",
+			StatusCode: http.StatusBadRequest,
+			Error:      fmt.Errorf(`Code="BMSUserErrorConcurrentOperation" Message="Operation failed as a concurrent operation is in progress."`),
+			Expected:   true,
+		},
+		{
+			Name:       "Other Error",
+			StatusCode: http.StatusBadRequest,
+			Error:      fmt.Errorf(`Code="BadRequest" Message="The request is invalid."`),
+			Expected:   false,
+		},
+		{
+			Name:       "No Response",
+			StatusCode: 0,
+			Error:      fmt.Errorf("connection reset by peer"),
+			Expected:   false,
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q..", v.Name)
+
+		resp := autorest.Response{}
+		if v.StatusCode != 0 {
+			resp.Response = &http.Response{
+				StatusCode: v.StatusCode,
+			}
+		}
+
+		if actual := backupOperationInProgress(resp, v.Error); actual != v.Expected {
+			t.Fatalf("Expected %t but got %t", v.Expected, actual)
+		}
+	}
+}
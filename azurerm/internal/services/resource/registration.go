@@ -26,6 +26,7 @@ func (r Registration) SupportedDataSources() map[string]*schema.Resource {
 	return map[string]*schema.Resource{
 		"azurerm_resources":      dataSourceResources(),
 		"azurerm_resource_group": dataSourceResourceGroup(),
+		"azurerm_resource_id":    dataSourceResourceId(),
 	}
 }
 
@@ -0,0 +1,223 @@
+package resource
+
+import (
+	"fmt"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+)
+
+func dataSourceResourceId() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceResourceIdRead,
+
+		Timeouts: &schema.ResourceTimeout{
+			Read: schema.DefaultTimeout(5 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"resource_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validateResourceIdSegments,
+			},
+
+			"subscription_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"resource_group_name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"provider_namespace": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"resource_type": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"parent_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"segment": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceResourceIdRead(d *schema.ResourceData, _ interface{}) error {
+	input := d.Get("resource_id").(string)
+
+	id, err := parseResourceIdSegments(input)
+	if err != nil {
+		return fmt.Errorf("parsing %q: %+v", input, err)
+	}
+
+	d.SetId(input)
+
+	d.Set("subscription_id", id.subscriptionId)
+	d.Set("resource_group_name", id.resourceGroup)
+	d.Set("provider_namespace", id.providerNamespace)
+	d.Set("resource_type", id.resourceType)
+	d.Set("name", id.name)
+	d.Set("parent_id", id.parentId)
+
+	segments := make([]interface{}, 0)
+	for _, segment := range id.segments {
+		segments = append(segments, map[string]interface{}{
+			"type": segment.key,
+			"name": segment.value,
+		})
+	}
+	if err := d.Set("segment", segments); err != nil {
+		return fmt.Errorf("setting `segment`: %+v", err)
+	}
+
+	return nil
+}
+
+type resourceIdSegment struct {
+	key   string
+	value string
+}
+
+type resourceIdDetails struct {
+	subscriptionId    string
+	resourceGroup     string
+	providerNamespace string
+	resourceType      string
+	name              string
+	parentId          string
+	segments          []resourceIdSegment
+}
+
+func validateResourceIdSegments(i interface{}, k string) (warnings []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %q to be string", k))
+		return
+	}
+
+	if _, err := parseResourceIdSegments(v); err != nil {
+		errors = append(errors, fmt.Errorf("Can not parse %q as a resource id: %v", k, err))
+	}
+
+	return warnings, errors
+}
+
+// parseResourceIdSegments parses an arbitrary Resource Manager ID, retaining the order of the segments so that
+// the (fully qualified) Resource Type and the Parent ID can be determined. For extension resources (e.g.
+// Diagnostic Settings) the Provider Namespace and Resource Type are those of the last `providers` segment.
+// IDs which aren't scoped to a Subscription (e.g. Management Groups, or Tenant-level resources) are supported.
+func parseResourceIdSegments(input string) (*resourceIdDetails, error) {
+	// split the decoded path, so that any query string is ignored
+	idURL, err := url.ParseRequestURI(input)
+	if err != nil {
+		return nil, fmt.Errorf("parsing Resource ID: %+v", err)
+	}
+	path := strings.Trim(idURL.Path, "/")
+	if path == "" {
+		return nil, fmt.Errorf("no path segments were found in %q", input)
+	}
+	components := strings.Split(path, "/")
+	if len(components)%2 != 0 {
+		return nil, fmt.Errorf("the number of path segments is not divisible by 2 in %q", idURL.Path)
+	}
+	for _, component := range components {
+		if component == "" {
+			return nil, fmt.Errorf("empty path segments aren't supported in %q", idURL.Path)
+		}
+	}
+
+	// Resource Manager IDs are scoped to either a Subscription or (e.g. for Management Groups) the Tenant
+	if !strings.EqualFold(components[0], "subscriptions") && !strings.EqualFold(components[0], "providers") {
+		return nil, fmt.Errorf("expected the Resource ID to start with either `/subscriptions` or `/providers` but got %q", idURL.Path)
+	}
+
+	details := resourceIdDetails{
+		segments: make([]resourceIdSegment, 0),
+	}
+
+	typeSegments := make([]string, 0)
+	for i := 0; i+1 < len(components); i += 2 {
+		key := components[i]
+		value := components[i+1]
+
+		switch {
+		case i == 0 && strings.EqualFold(key, "subscriptions"):
+			details.subscriptionId = value
+			details.providerNamespace = "Microsoft.Resources"
+			typeSegments = []string{"subscriptions"}
+
+		case i == 2 && strings.EqualFold(key, "resourceGroups"):
+			details.resourceGroup = value
+			typeSegments = []string{"resourceGroups"}
+
+		case strings.EqualFold(key, "providers"):
+			if i+2 >= len(components) {
+				// a Resource Provider e.g. `/subscriptions/00000000-0000-0000-0000-000000000000/providers/Microsoft.Compute`
+				details.providerNamespace = "Microsoft.Resources"
+				typeSegments = []string{"providers"}
+				break
+			}
+
+			details.providerNamespace = value
+			typeSegments = make([]string, 0)
+			continue
+
+		default:
+			typeSegments = append(typeSegments, key)
+		}
+
+		details.segments = append(details.segments, resourceIdSegment{
+			key:   key,
+			value: value,
+		})
+		details.name = value
+
+		if i > 0 {
+			parentComponents := components[0:i]
+			// the Parent of an extension resource is the resource it's scoped to
+			if len(parentComponents) >= 2 && strings.EqualFold(parentComponents[len(parentComponents)-2], "providers") {
+				parentComponents = parentComponents[0 : len(parentComponents)-2]
+			}
+			if len(parentComponents) > 0 {
+				details.parentId = "/" + strings.Join(parentComponents, "/")
+			}
+		}
+	}
+
+	details.resourceType = fmt.Sprintf("%s/%s", details.providerNamespace, strings.Join(typeSegments, "/"))
+
+	return &details, nil
+}
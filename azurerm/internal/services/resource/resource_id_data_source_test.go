@@ -0,0 +1,94 @@
+package resource_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/acceptance"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/acceptance/check"
+)
+
+type ResourceIdDataSource struct {
+}
+
+func TestAccDataSourceResourceId_nested(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azurerm_resource_id", "test")
+	r := ResourceIdDataSource{}
+
+	data.DataSourceTest(t, []resource.TestStep{
+		{
+			Config: r.nested(),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("subscription_id").HasValue("00000000-0000-0000-0000-000000000000"),
+				check.That(data.ResourceName).Key("resource_group_name").HasValue("group1"),
+				check.That(data.ResourceName).Key("provider_namespace").HasValue("Microsoft.Storage"),
+				check.That(data.ResourceName).Key("resource_type").HasValue("Microsoft.Storage/storageAccounts/blobServices/containers"),
+				check.That(data.ResourceName).Key("name").HasValue("container1"),
+				check.That(data.ResourceName).Key("parent_id").HasValue("/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.Storage/storageAccounts/account1/blobServices/default"),
+				check.That(data.ResourceName).Key("segment.#").HasValue("5"),
+				check.That(data.ResourceName).Key("segment.2.type").HasValue("storageAccounts"),
+				check.That(data.ResourceName).Key("segment.2.name").HasValue("account1"),
+			),
+		},
+	})
+}
+
+func TestAccDataSourceResourceId_extension(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azurerm_resource_id", "test")
+	r := ResourceIdDataSource{}
+
+	data.DataSourceTest(t, []resource.TestStep{
+		{
+			Config: r.extension(),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("resource_group_name").HasValue("group1"),
+				check.That(data.ResourceName).Key("provider_namespace").HasValue("Microsoft.Insights"),
+				check.That(data.ResourceName).Key("resource_type").HasValue("Microsoft.Insights/diagnosticSettings"),
+				check.That(data.ResourceName).Key("name").HasValue("setting1"),
+				check.That(data.ResourceName).Key("parent_id").HasValue("/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.Storage/storageAccounts/account1"),
+			),
+		},
+	})
+}
+
+func TestAccDataSourceResourceId_managementGroup(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azurerm_resource_id", "test")
+	r := ResourceIdDataSource{}
+
+	data.DataSourceTest(t, []resource.TestStep{
+		{
+			Config: r.managementGroup(),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("subscription_id").HasValue(""),
+				check.That(data.ResourceName).Key("provider_namespace").HasValue("Microsoft.Authorization"),
+				check.That(data.ResourceName).Key("resource_type").HasValue("Microsoft.Authorization/policyDefinitions"),
+				check.That(data.ResourceName).Key("name").HasValue("definition1"),
+				check.That(data.ResourceName).Key("parent_id").HasValue("/providers/Microsoft.Management/managementGroups/group1"),
+			),
+		},
+	})
+}
+
+func (ResourceIdDataSource) nested() string {
+	return `
+data "azurerm_resource_id" "test" {
+  resource_id = "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.Storage/storageAccounts/account1/blobServices/default/containers/container1"
+}
+`
+}
+
+func (ResourceIdDataSource) extension() string {
+	return `
+data "azurerm_resource_id" "test" {
+  resource_id = "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.Storage/storageAccounts/account1/providers/Microsoft.Insights/diagnosticSettings/setting1"
+}
+`
+}
+
+func (ResourceIdDataSource) managementGroup() string {
+	return `
+data "azurerm_resource_id" "test" {
+  resource_id = "/providers/Microsoft.Management/managementGroups/group1/providers/Microsoft.Authorization/policyDefinitions/definition1"
+}
+`
+}
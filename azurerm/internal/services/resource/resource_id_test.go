@@ -0,0 +1,214 @@
+package resource
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestParseResourceIdSegments(t *testing.T) {
+	testData := []struct {
+		Name     string
+		Input    string
+		Error    bool
+		Expected *resourceIdDetails
+	}{
+		{
+			Name:  "Empty",
+			Input: "",
+			Error: true,
+		},
+		{
+			Name:  "Not a Path",
+			Input: "hello-world",
+			Error: true,
+		},
+		{
+			Name:  "Odd Number of Segments",
+			Input: "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups",
+			Error: true,
+		},
+		{
+			Name:  "Missing Value",
+			Input: "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups//",
+			Error: true,
+		},
+		{
+			Name:  "No Subscription",
+			Input: "/resourceGroups/group1",
+			Error: true,
+		},
+		{
+			Name:  "Odd Number of Segments with Query String",
+			Input: "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups?api-version=2020-06-01",
+			Error: true,
+		},
+		{
+			Name:  "Subscription",
+			Input: "/subscriptions/00000000-0000-0000-0000-000000000000",
+			Expected: &resourceIdDetails{
+				subscriptionId:    "00000000-0000-0000-0000-000000000000",
+				providerNamespace: "Microsoft.Resources",
+				resourceType:      "Microsoft.Resources/subscriptions",
+				name:              "00000000-0000-0000-0000-000000000000",
+				segments: []resourceIdSegment{
+					{key: "subscriptions", value: "00000000-0000-0000-0000-000000000000"},
+				},
+			},
+		},
+		{
+			Name:  "Resource Group",
+			Input: "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1",
+			Expected: &resourceIdDetails{
+				subscriptionId:    "00000000-0000-0000-0000-000000000000",
+				resourceGroup:     "group1",
+				providerNamespace: "Microsoft.Resources",
+				resourceType:      "Microsoft.Resources/resourceGroups",
+				name:              "group1",
+				parentId:          "/subscriptions/00000000-0000-0000-0000-000000000000",
+				segments: []resourceIdSegment{
+					{key: "subscriptions", value: "00000000-0000-0000-0000-000000000000"},
+					{key: "resourceGroups", value: "group1"},
+				},
+			},
+		},
+		{
+			Name:  "Nested Resource with Query String",
+			Input: "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.Network/virtualNetworks/network1/subnets/subnet1?api-version=2020-06-01",
+			Expected: &resourceIdDetails{
+				subscriptionId:    "00000000-0000-0000-0000-000000000000",
+				resourceGroup:     "group1",
+				providerNamespace: "Microsoft.Network",
+				resourceType:      "Microsoft.Network/virtualNetworks/subnets",
+				name:              "subnet1",
+				parentId:          "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.Network/virtualNetworks/network1",
+				segments: []resourceIdSegment{
+					{key: "subscriptions", value: "00000000-0000-0000-0000-000000000000"},
+					{key: "resourceGroups", value: "group1"},
+					{key: "virtualNetworks", value: "network1"},
+					{key: "subnets", value: "subnet1"},
+				},
+			},
+		},
+		{
+			Name:  "Encoded Slash",
+			Input: "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1%2Fproviders/Microsoft.Network",
+			Expected: &resourceIdDetails{
+				subscriptionId:    "00000000-0000-0000-0000-000000000000",
+				resourceGroup:     "group1",
+				providerNamespace: "Microsoft.Resources",
+				resourceType:      "Microsoft.Resources/providers",
+				name:              "Microsoft.Network",
+				parentId:          "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1",
+				segments: []resourceIdSegment{
+					{key: "subscriptions", value: "00000000-0000-0000-0000-000000000000"},
+					{key: "resourceGroups", value: "group1"},
+					{key: "providers", value: "Microsoft.Network"},
+				},
+			},
+		},
+		{
+			Name:  "Extension Resource",
+			Input: "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.Storage/storageAccounts/account1/providers/Microsoft.Insights/diagnosticSettings/setting1",
+			Expected: &resourceIdDetails{
+				subscriptionId:    "00000000-0000-0000-0000-000000000000",
+				resourceGroup:     "group1",
+				providerNamespace: "Microsoft.Insights",
+				resourceType:      "Microsoft.Insights/diagnosticSettings",
+				name:              "setting1",
+				parentId:          "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.Storage/storageAccounts/account1",
+				segments: []resourceIdSegment{
+					{key: "subscriptions", value: "00000000-0000-0000-0000-000000000000"},
+					{key: "resourceGroups", value: "group1"},
+					{key: "storageAccounts", value: "account1"},
+					{key: "diagnosticSettings", value: "setting1"},
+				},
+			},
+		},
+		{
+			Name:  "Tenant Resource Provider",
+			Input: "/providers/Microsoft.Compute",
+			Expected: &resourceIdDetails{
+				providerNamespace: "Microsoft.Resources",
+				resourceType:      "Microsoft.Resources/providers",
+				name:              "Microsoft.Compute",
+				segments: []resourceIdSegment{
+					{key: "providers", value: "Microsoft.Compute"},
+				},
+			},
+		},
+		{
+			Name:  "Subscription Resource Provider",
+			Input: "/subscriptions/00000000-0000-0000-0000-000000000000/providers/Microsoft.Compute",
+			Expected: &resourceIdDetails{
+				subscriptionId:    "00000000-0000-0000-0000-000000000000",
+				providerNamespace: "Microsoft.Resources",
+				resourceType:      "Microsoft.Resources/providers",
+				name:              "Microsoft.Compute",
+				parentId:          "/subscriptions/00000000-0000-0000-0000-000000000000",
+				segments: []resourceIdSegment{
+					{key: "subscriptions", value: "00000000-0000-0000-0000-000000000000"},
+					{key: "providers", value: "Microsoft.Compute"},
+				},
+			},
+		},
+		{
+			Name:  "Tenant Resource",
+			Input: "/providers/Microsoft.Authorization/roleDefinitions/00000000-0000-0000-0000-000000000000",
+			Expected: &resourceIdDetails{
+				providerNamespace: "Microsoft.Authorization",
+				resourceType:      "Microsoft.Authorization/roleDefinitions",
+				name:              "00000000-0000-0000-0000-000000000000",
+				segments: []resourceIdSegment{
+					{key: "roleDefinitions", value: "00000000-0000-0000-0000-000000000000"},
+				},
+			},
+		},
+		{
+			Name:  "Management Group",
+			Input: "/providers/Microsoft.Management/managementGroups/group1",
+			Expected: &resourceIdDetails{
+				providerNamespace: "Microsoft.Management",
+				resourceType:      "Microsoft.Management/managementGroups",
+				name:              "group1",
+				segments: []resourceIdSegment{
+					{key: "managementGroups", value: "group1"},
+				},
+			},
+		},
+		{
+			Name:  "Management Group Extension Resource",
+			Input: "/providers/Microsoft.Management/managementGroups/group1/providers/Microsoft.Authorization/policyDefinitions/definition1",
+			Expected: &resourceIdDetails{
+				providerNamespace: "Microsoft.Authorization",
+				resourceType:      "Microsoft.Authorization/policyDefinitions",
+				name:              "definition1",
+				parentId:          "/providers/Microsoft.Management/managementGroups/group1",
+				segments: []resourceIdSegment{
+					{key: "managementGroups", value: "group1"},
+					{key: "policyDefinitions", value: "definition1"},
+				},
+			},
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q..", v.Name)
+
+		actual, err := parseResourceIdSegments(v.Input)
+		if err != nil {
+			if v.Error {
+				continue
+			}
+
+			t.Fatalf("Expected no error but got: %+v", err)
+		}
+
+		if v.Error {
+			t.Fatalf("Expected an error but didn't get one")
+		}
+
+		if !reflect.DeepEqual(*v.Expected, *actual) {
+			t.Fatalf("Expected %+v but got %+v", *v.Expected, *actual)
+		}
+	}
+}
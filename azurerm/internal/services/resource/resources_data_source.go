@@ -9,10 +9,12 @@ import (
 	"github.com/Azure/azure-sdk-for-go/services/resources/mgmt/2020-06-01/resources"
 	"github.com/google/uuid"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/clients"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/tags"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/timeouts"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
 
 func dataSourceResources() *schema.Resource {
@@ -34,13 +36,37 @@ func dataSourceResources() *schema.Resource {
 				Computed: true,
 			},
 			"type": {
-				Type:     schema.TypeString,
+				Type:          schema.TypeString,
+				Optional:      true,
+				Computed:      true,
+				ConflictsWith: []string{"types"},
+			},
+
+			"types": {
+				Type:     schema.TypeSet,
 				Optional: true,
-				Computed: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringIsNotEmpty,
+				},
+				Set:           schema.HashString,
+				ConflictsWith: []string{"type"},
 			},
 
 			"required_tags": tags.Schema(),
 
+			"required_tag_names": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringIsNotEmpty,
+				},
+				Set: schema.HashString,
+			},
+
+			"not_tags": tags.Schema(),
+
 			"resources": {
 				Type:     schema.TypeList,
 				Computed: true,
@@ -74,49 +100,56 @@ func dataSourceResourcesRead(d *schema.ResourceData, meta interface{}) error {
 
 	resourceGroupName := d.Get("resource_group_name").(string)
 	resourceName := d.Get("name").(string)
-	resourceType := d.Get("type").(string)
-	requiredTags := d.Get("required_tags").(map[string]interface{})
-
-	if resourceGroupName == "" && resourceName == "" && resourceType == "" {
-		return fmt.Errorf("At least one of `name`, `resource_group_name` or `type` must be specified")
+	resourceTypes := utils.ExpandStringSlice(d.Get("types").(*schema.Set).List())
+	if v := d.Get("type").(string); v != "" {
+		resourceTypes = &[]string{v}
+	}
+	tagFilter := resourcesTagFilter{
+		requiredTags:     d.Get("required_tags").(map[string]interface{}),
+		requiredTagNames: *utils.ExpandStringSlice(d.Get("required_tag_names").(*schema.Set).List()),
+		notTags:          d.Get("not_tags").(map[string]interface{}),
 	}
 
-	var filter string
+	if resourceGroupName == "" && resourceName == "" && len(*resourceTypes) == 0 {
+		return fmt.Errorf("At least one of `name`, `resource_group_name`, `type` or `types` must be specified")
+	}
 
+	filters := make([]string, 0)
 	if resourceGroupName != "" {
-		v := fmt.Sprintf("resourceGroup eq '%s'", resourceGroupName)
-		filter += v
+		filters = append(filters, fmt.Sprintf("resourceGroup eq '%s'", resourceGroupName))
 	}
 
 	if resourceName != "" {
-		if strings.Contains(filter, "eq") {
-			filter += " and "
-		}
-		v := fmt.Sprintf("name eq '%s'", resourceName)
-		filter += v
+		filters = append(filters, fmt.Sprintf("name eq '%s'", resourceName))
 	}
 
-	if resourceType != "" {
-		if strings.Contains(filter, "eq") {
-			filter += " and "
-		}
-		v := fmt.Sprintf("resourceType eq '%s'", resourceType)
-		filter += v
+	// the API only supports filtering on a single Resource Type, so when multiple are specified we make a
+	// request per Resource Type - which is filtered server-side, rather than listing and filtering everything
+	queries := make([]string, 0)
+	for _, resourceType := range *resourceTypes {
+		typeFilters := append([]string{}, filters...)
+		typeFilters = append(typeFilters, fmt.Sprintf("resourceType eq '%s'", resourceType))
+		queries = append(queries, strings.Join(typeFilters, " and "))
+	}
+	if len(queries) == 0 {
+		queries = append(queries, strings.Join(filters, " and "))
 	}
 
-	// Use List instead of listComplete because of bug in SDK: https://github.com/Azure/azure-sdk-for-go/issues/9510
 	resources := make([]map[string]interface{}, 0)
-	resourcesResp, err := client.List(ctx, filter, "", nil)
-	if err != nil {
-		return fmt.Errorf("Error getting resources: %+v", err)
-	}
+	for _, filter := range queries {
+		// Use List instead of listComplete because of bug in SDK: https://github.com/Azure/azure-sdk-for-go/issues/9510
+		resourcesResp, err := client.List(ctx, filter, "", nil)
+		if err != nil {
+			return fmt.Errorf("Error getting resources: %+v", err)
+		}
 
-	resources = append(resources, filterResource(resourcesResp.Values(), requiredTags)...)
-	for resourcesResp.Response().NextLink != nil && *resourcesResp.Response().NextLink != "" {
-		if err := resourcesResp.NextWithContext(ctx); err != nil {
-			return fmt.Errorf("loading Resource List: %+v", err)
+		resources = append(resources, filterResource(resourcesResp.Values(), tagFilter)...)
+		for resourcesResp.Response().NextLink != nil && *resourcesResp.Response().NextLink != "" {
+			if err := resourcesResp.NextWithContext(ctx); err != nil {
+				return fmt.Errorf("loading Resource List: %+v", err)
+			}
+			resources = append(resources, filterResource(resourcesResp.Values(), tagFilter)...)
 		}
-		resources = append(resources, filterResource(resourcesResp.Values(), requiredTags)...)
 	}
 
 	d.SetId("resource-" + uuid.New().String())
@@ -127,7 +160,41 @@ func dataSourceResourcesRead(d *schema.ResourceData, meta interface{}) error {
 	return nil
 }
 
-func filterResource(inputs []resources.GenericResourceExpanded, requiredTags map[string]interface{}) []map[string]interface{} {
+type resourcesTagFilter struct {
+	// requiredTags are the tags (and values) which must all be present on the resource
+	requiredTags map[string]interface{}
+
+	// requiredTagNames are the names of the tags which must all be present on the resource, regardless of value
+	requiredTagNames []string
+
+	// notTags are the tags (and values) which must not be present on the resource
+	notTags map[string]interface{}
+}
+
+func (f resourcesTagFilter) matches(input map[string]*string) bool {
+	for requiredTagName, requiredTagVal := range f.requiredTags {
+		tagVal, ok := input[requiredTagName]
+		if !ok || tagVal == nil || requiredTagVal != *tagVal {
+			return false
+		}
+	}
+
+	for _, requiredTagName := range f.requiredTagNames {
+		if _, ok := input[requiredTagName]; !ok {
+			return false
+		}
+	}
+
+	for notTagName, notTagVal := range f.notTags {
+		if tagVal, ok := input[notTagName]; ok && tagVal != nil && notTagVal == *tagVal {
+			return false
+		}
+	}
+
+	return true
+}
+
+func filterResource(inputs []resources.GenericResourceExpanded, tagFilter resourcesTagFilter) []map[string]interface{} {
 	var result []map[string]interface{}
 	for _, res := range inputs {
 		if res.ID == nil {
@@ -136,18 +203,7 @@ func filterResource(inputs []resources.GenericResourceExpanded, requiredTags map
 
 		// currently its not supported to use tags filter with other filters
 		// therefore we need to filter the resources manually.
-		tagMatches := 0
-		if res.Tags != nil {
-			for requiredTagName, requiredTagVal := range requiredTags {
-				for tagName, tagVal := range res.Tags {
-					if requiredTagName == tagName && tagVal != nil && requiredTagVal == *tagVal {
-						tagMatches++
-					}
-				}
-			}
-		}
-
-		if tagMatches == len(requiredTags) {
+		if tagFilter.matches(res.Tags) {
 			resName := ""
 			if res.Name != nil {
 				resName = *res.Name
@@ -186,7 +242,7 @@ func filterResource(inputs []resources.GenericResourceExpanded, requiredTags map
 				"tags":     resTags,
 			})
 		} else {
-			log.Printf("[DEBUG] azurerm_resources - resources %q (id: %q) skipped as a required tag is not set or has the wrong value.", *res.Name, *res.ID)
+			log.Printf("[DEBUG] azurerm_resources - resources %q (id: %q) skipped as it doesn't match the tag filters.", *res.Name, *res.ID)
 		}
 	}
 	return result
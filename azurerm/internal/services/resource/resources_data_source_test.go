@@ -80,6 +80,46 @@ func TestAccDataSourceResources_FilteredByTags(t *testing.T) {
 	})
 }
 
+func TestAccDataSourceResources_ByResourceTypes(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azurerm_resources", "test")
+	r := ResourcesDataSource{}
+
+	data.DataSourceTest(t, []resource.TestStep{
+		{
+			Config: r.templateMultipleTypes(data),
+		},
+		{
+			Config: r.ByResourceTypes(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("resources.#").HasValue("2"),
+			),
+		},
+	})
+}
+
+func TestAccDataSourceResources_FilteredByTagOperators(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azurerm_resources", "test")
+	r := ResourcesDataSource{}
+
+	data.DataSourceTest(t, []resource.TestStep{
+		{
+			Config: r.template(data),
+		},
+		{
+			Config: r.FilteredByTagNames(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("resources.#").HasValue("1"),
+			),
+		},
+		{
+			Config: r.FilteredByNotTags(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("resources.#").HasValue("0"),
+			),
+		},
+	})
+}
+
 func (r ResourcesDataSource) ByName(data acceptance.TestData) string {
 	return fmt.Sprintf(`
 %s
@@ -111,6 +151,42 @@ data "azurerm_resources" "test" {
 `, r.template(data))
 }
 
+func (r ResourcesDataSource) ByResourceTypes(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+data "azurerm_resources" "test" {
+  resource_group_name = azurerm_storage_account.test.resource_group_name
+  types               = ["Microsoft.Storage/storageAccounts", "Microsoft.Network/virtualNetworks", "Microsoft.Network/publicIPAddresses"]
+}
+`, r.templateMultipleTypes(data))
+}
+
+func (r ResourcesDataSource) FilteredByTagNames(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+data "azurerm_resources" "test" {
+  resource_group_name = azurerm_storage_account.test.resource_group_name
+  required_tag_names  = ["environment"]
+}
+`, r.template(data))
+}
+
+func (r ResourcesDataSource) FilteredByNotTags(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+data "azurerm_resources" "test" {
+  resource_group_name = azurerm_storage_account.test.resource_group_name
+
+  not_tags = {
+    environment = "production"
+  }
+}
+`, r.template(data))
+}
+
 func (r ResourcesDataSource) FilteredByTags(data acceptance.TestData) string {
 	return fmt.Sprintf(`
 %s
@@ -151,3 +227,16 @@ resource "azurerm_storage_account" "test" {
 }
 `, data.RandomInteger, data.Locations.Primary, data.RandomString)
 }
+
+func (r ResourcesDataSource) templateMultipleTypes(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_virtual_network" "test" {
+  name                = "acctestvirtnet%d"
+  address_space       = ["10.0.0.0/16"]
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+}
+`, r.template(data), data.RandomInteger)
+}
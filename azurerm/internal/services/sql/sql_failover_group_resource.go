@@ -132,6 +132,16 @@ func resourceSqlFailoverGroup() *schema.Resource {
 				Computed: true,
 			},
 
+			"read_write_endpoint_fqdn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"read_only_endpoint_fqdn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
 			"tags": tags.Schema(),
 		},
 	}
@@ -222,6 +232,11 @@ func resourceSqlFailoverGroupRead(d *schema.ResourceData, meta interface{}) erro
 	d.Set("server_name", id.ServerName)
 	d.Set("resource_group_name", id.ResourceGroup)
 
+	// the listener endpoints follow the primary/secondary servers, so they're only exposed via the Failover Group's name
+	dnsSuffix := meta.(*clients.Client).Account.Environment.SQLDatabaseDNSSuffix
+	d.Set("read_write_endpoint_fqdn", fmt.Sprintf("%s.%s", id.Name, dnsSuffix))
+	d.Set("read_only_endpoint_fqdn", fmt.Sprintf("%s.secondary.%s", id.Name, dnsSuffix))
+
 	if location := resp.Location; location != nil {
 		d.Set("location", azure.NormalizeLocation(*location))
 	}
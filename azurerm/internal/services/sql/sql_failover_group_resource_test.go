@@ -25,6 +25,8 @@ func TestAccSqlFailoverGroup_basic(t *testing.T) {
 			Config: r.basic(data),
 			Check: resource.ComposeTestCheckFunc(
 				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("read_write_endpoint_fqdn").HasValue(fmt.Sprintf("acctestsfg%d.database.windows.net", data.RandomInteger)),
+				check.That(data.ResourceName).Key("read_only_endpoint_fqdn").HasValue(fmt.Sprintf("acctestsfg%d.secondary.database.windows.net", data.RandomInteger)),
 			),
 		},
 		data.ImportStep(),
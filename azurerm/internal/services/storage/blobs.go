@@ -3,6 +3,7 @@ package storage
 import (
 	"bytes"
 	"context"
+	"crypto/md5"
 	"encoding/base64"
 	"encoding/hex"
 	"fmt"
@@ -150,6 +151,16 @@ func (sbu BlobUpload) uploadBlockBlob(ctx context.Context) error {
 	}
 	defer file.Close()
 
+	info, err := file.Stat()
+	if err != nil {
+		return fmt.Errorf("Could not stat file %q: %s", file.Name(), err)
+	}
+
+	// larger files are split into blocks which are uploaded in parallel and then committed
+	if info.Size() > blockSize {
+		return sbu.blockUploadFromSource(ctx, file, info.Size())
+	}
+
 	input := blobs.PutBlockBlobInput{
 		ContentType: utils.String(sbu.ContentType),
 		MetaData:    sbu.MetaData,
@@ -363,6 +374,121 @@ func (sbu BlobUpload) blobPageUploadWorker(ctx context.Context, uploadCtx blobPa
 	}
 }
 
+// the size of each block when uploading a Block Blob in chunks, which allows for files up to ~195GB
+const blockSize int64 = 4 * 1024 * 1024
+
+type storageBlobBlock struct {
+	id      string
+	section *io.SectionReader
+}
+
+func (sbu BlobUpload) blockUploadFromSource(ctx context.Context, file io.ReaderAt, fileSize int64) error {
+	workerCount := sbu.Parallelism * runtime.NumCPU()
+
+	// first we chunk the file into fixed-size blocks - the block ID's must all be the same length
+	blockList := make([]storageBlobBlock, 0)
+	for offset, i := int64(0), 0; offset < fileSize; offset, i = offset+blockSize, i+1 {
+		length := blockSize
+		if offset+length > fileSize {
+			length = fileSize - offset
+		}
+
+		blockList = append(blockList, storageBlobBlock{
+			id:      base64.StdEncoding.EncodeToString([]byte(fmt.Sprintf("%032d", i))),
+			section: io.NewSectionReader(file, offset, length),
+		})
+	}
+
+	// then upload each of the blocks
+	blocks := make(chan storageBlobBlock, len(blockList))
+	errors := make(chan error, len(blockList))
+	wg := &sync.WaitGroup{}
+	wg.Add(len(blockList))
+
+	for _, block := range blockList {
+		blocks <- block
+	}
+	close(blocks)
+
+	for i := 0; i < workerCount; i++ {
+		go sbu.blobBlockUploadWorker(ctx, blobBlockUploadContext{
+			blocks: blocks,
+			errors: errors,
+			wg:     wg,
+		})
+	}
+
+	wg.Wait()
+
+	if len(errors) > 0 {
+		return fmt.Errorf("Error while uploading source file %q: %s", sbu.Source, <-errors)
+	}
+
+	// finally commit the blocks, in order, to form the blob
+	blockIds := make([]blobs.BlockID, 0)
+	for _, block := range blockList {
+		blockIds = append(blockIds, blobs.BlockID{Value: block.id})
+	}
+
+	input := blobs.PutBlockListInput{
+		BlockList: blobs.BlockList{
+			LatestBlockIDs: blockIds,
+		},
+		ContentType: utils.String(sbu.ContentType),
+		MetaData:    sbu.MetaData,
+	}
+	if sbu.ContentMD5 != "" {
+		input.ContentMD5 = utils.String(sbu.ContentMD5)
+	}
+	if _, err := sbu.Client.PutBlockList(ctx, sbu.AccountName, sbu.ContainerName, sbu.BlobName, input); err != nil {
+		return fmt.Errorf("Error PutBlockList: %s", err)
+	}
+
+	return nil
+}
+
+type blobBlockUploadContext struct {
+	blocks chan storageBlobBlock
+	errors chan error
+	wg     *sync.WaitGroup
+}
+
+func (sbu BlobUpload) blobBlockUploadWorker(ctx context.Context, uploadCtx blobBlockUploadContext) {
+	for block := range uploadCtx.blocks {
+		if err := sbu.uploadBlock(ctx, block); err != nil {
+			uploadCtx.errors <- err
+		}
+
+		uploadCtx.wg.Done()
+	}
+}
+
+func (sbu BlobUpload) uploadBlock(ctx context.Context, block storageBlobBlock) error {
+	chunk := make([]byte, block.section.Size())
+	if _, err := block.section.Read(chunk); err != nil && err != io.EOF {
+		return fmt.Errorf("Error reading block %q from source file %q: %s", block.id, sbu.Source, err)
+	}
+
+	input := blobs.PutBlockInput{
+		BlockID: block.id,
+		Content: chunk,
+	}
+	result, err := sbu.Client.PutBlock(ctx, sbu.AccountName, sbu.ContainerName, sbu.BlobName, input)
+	if err != nil {
+		return fmt.Errorf("Error writing block %q for file %q: %s", block.id, sbu.Source, err)
+	}
+
+	// the service returns the MD5 of the block it received, which allows for verifying the integrity of each block
+	if result.ContentMD5 != "" {
+		hash := md5.Sum(chunk)
+		if expected := base64.StdEncoding.EncodeToString(hash[:]); expected != result.ContentMD5 {
+			return fmt.Errorf("the MD5 of block %q for file %q didn't match - expected %q but got %q", block.id, sbu.Source, expected, result.ContentMD5)
+		}
+	}
+
+	return nil
+}
+
 func convertHexToBase64Encoding(str string) (string, error) {
 	data, err := hex.DecodeString(str)
 	if err != nil {
@@ -0,0 +1,229 @@
+package storage
+
+import (
+	"bytes"
+	"context"
+	"crypto/md5"
+	"encoding/base64"
+	"encoding/xml"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/tombuildsstuff/giovanni/storage/2019-12-12/blob/blobs"
+)
+
+// blockUploadSender records the blocks which are uploaded and committed, optionally failing
+// (or returning the wrong MD5 for) the block with the specified index
+type blockUploadSender struct {
+	sync.Mutex
+
+	failBlock        int
+	wrongMD5ForBlock int
+
+	blocks          map[string][]byte
+	committedBlocks []string
+}
+
+func (s *blockUploadSender) Do(r *http.Request) (*http.Response, error) {
+	s.Lock()
+	defer s.Unlock()
+
+	body, err := ioutil.ReadAll(r.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	statusCode := http.StatusCreated
+	header := http.Header{}
+
+	switch r.URL.Query().Get("comp") {
+	case "block":
+		blockId := r.URL.Query().Get("blockid")
+		if s.isBlock(blockId, s.failBlock) {
+			statusCode = http.StatusBadRequest
+			break
+		}
+
+		hash := md5.Sum(body)
+		if s.isBlock(blockId, s.wrongMD5ForBlock) {
+			hash = md5.Sum([]byte("hello-world"))
+		}
+		header.Set("Content-MD5", base64.StdEncoding.EncodeToString(hash[:]))
+		s.blocks[blockId] = body
+
+	case "blocklist":
+		var blockList blobs.BlockList
+		if err := xml.Unmarshal(body, &blockList); err != nil {
+			return nil, err
+		}
+		for _, block := range blockList.LatestBlockIDs {
+			s.committedBlocks = append(s.committedBlocks, block.Value)
+		}
+	}
+
+	return &http.Response{
+		StatusCode: statusCode,
+		Status:     http.StatusText(statusCode),
+		Header:     header,
+		Body:       ioutil.NopCloser(strings.NewReader("")),
+		Request:    r,
+	}, nil
+}
+
+func (s *blockUploadSender) isBlock(blockId string, index int) bool {
+	if index < 0 {
+		return false
+	}
+
+	decoded, err := base64.StdEncoding.DecodeString(blockId)
+	if err != nil {
+		return false
+	}
+
+	return string(decoded) == fmt.Sprintf("%032d", index)
+}
+
+func TestBlobUploadBlockUploadFromSource(t *testing.T) {
+	cases := []struct {
+		Name             string
+		FileSize         int64
+		FailBlock        int
+		WrongMD5ForBlock int
+		ExpectedBlocks   []int64
+		ExpectedError    string
+	}{
+		{
+			Name:             "Empty File",
+			FileSize:         0,
+			FailBlock:        -1,
+			WrongMD5ForBlock: -1,
+			ExpectedBlocks:   []int64{},
+		},
+		{
+			Name:             "Smaller than a Block",
+			FileSize:         1024,
+			FailBlock:        -1,
+			WrongMD5ForBlock: -1,
+			ExpectedBlocks:   []int64{1024},
+		},
+		{
+			Name:             "Exactly one Block",
+			FileSize:         blockSize,
+			FailBlock:        -1,
+			WrongMD5ForBlock: -1,
+			ExpectedBlocks:   []int64{blockSize},
+		},
+		{
+			Name:             "Multiple Blocks with a smaller last Block",
+			FileSize:         2*blockSize + 10,
+			FailBlock:        -1,
+			WrongMD5ForBlock: -1,
+			ExpectedBlocks:   []int64{blockSize, blockSize, 10},
+		},
+		{
+			Name:             "Multiple Blocks which are all full",
+			FileSize:         3 * blockSize,
+			FailBlock:        -1,
+			WrongMD5ForBlock: -1,
+			ExpectedBlocks:   []int64{blockSize, blockSize, blockSize},
+		},
+		{
+			Name:             "PutBlock Error",
+			FileSize:         2*blockSize + 10,
+			FailBlock:        1,
+			WrongMD5ForBlock: -1,
+			ExpectedError:    "Error writing block",
+		},
+		{
+			Name:             "MD5 Mismatch",
+			FileSize:         2*blockSize + 10,
+			FailBlock:        -1,
+			WrongMD5ForBlock: 2,
+			ExpectedError:    "didn't match",
+		},
+	}
+
+	for _, tc := range cases {
+		t.Logf("[DEBUG] Testing %q..", tc.Name)
+
+		sender := &blockUploadSender{
+			failBlock:        tc.FailBlock,
+			wrongMD5ForBlock: tc.WrongMD5ForBlock,
+			blocks:           make(map[string][]byte),
+		}
+		client := blobs.New()
+		client.Sender = sender
+		client.RetryAttempts = 1
+
+		input := BlobUpload{
+			Client:        &client,
+			AccountName:   "account1",
+			ContainerName: "container1",
+			BlobName:      "blob1",
+			Parallelism:   2,
+			Source:        "file1",
+		}
+
+		content := make([]byte, tc.FileSize)
+		for i := range content {
+			content[i] = byte(i % 251)
+		}
+
+		err := input.blockUploadFromSource(context.TODO(), bytes.NewReader(content), tc.FileSize)
+		if tc.ExpectedError != "" {
+			if err == nil {
+				t.Fatalf("expected an error containing %q but didn't get one", tc.ExpectedError)
+			}
+			if !strings.Contains(err.Error(), tc.ExpectedError) {
+				t.Fatalf("expected an error containing %q but got: %+v", tc.ExpectedError, err)
+			}
+			if len(sender.committedBlocks) != 0 {
+				t.Fatalf("expected no blocks to be committed but got %d", len(sender.committedBlocks))
+			}
+			continue
+		}
+
+		if err != nil {
+			t.Fatalf("expected no error but got: %+v", err)
+		}
+
+		if len(sender.committedBlocks) != len(tc.ExpectedBlocks) {
+			t.Fatalf("expected %d blocks to be committed but got %d", len(tc.ExpectedBlocks), len(sender.committedBlocks))
+		}
+		if len(sender.blocks) != len(tc.ExpectedBlocks) {
+			t.Fatalf("expected %d unique blocks to be uploaded but got %d", len(tc.ExpectedBlocks), len(sender.blocks))
+		}
+
+		uploaded := make([]byte, 0)
+		for i, blockId := range sender.committedBlocks {
+			decoded, err := base64.StdEncoding.DecodeString(blockId)
+			if err != nil {
+				t.Fatalf("decoding block ID %q: %+v", blockId, err)
+			}
+			// the Block ID's within a Blob must all be the same length
+			if len(decoded) != 32 {
+				t.Fatalf("expected block ID %q to be 32 characters but got %d", string(decoded), len(decoded))
+			}
+			if !sender.isBlock(blockId, i) {
+				t.Fatalf("expected block %d to be committed at index %d but got %q", i, i, string(decoded))
+			}
+
+			block, ok := sender.blocks[blockId]
+			if !ok {
+				t.Fatalf("block %q was committed but never uploaded", string(decoded))
+			}
+			if int64(len(block)) != tc.ExpectedBlocks[i] {
+				t.Fatalf("expected block %d to be %d bytes but got %d", i, tc.ExpectedBlocks[i], len(block))
+			}
+			uploaded = append(uploaded, block...)
+		}
+
+		if !bytes.Equal(content, uploaded) {
+			t.Fatalf("expected the committed blocks to match the source file")
+		}
+	}
+}
@@ -32,6 +32,7 @@ type Client struct {
 	CloudEndpointsClient     *storagesync.CloudEndpointsClient
 	EncryptionScopesClient   *storage.EncryptionScopesClient
 	Environment              az.Environment
+	ObjectReplicationClient  *storage.ObjectReplicationPoliciesClient
 	SyncServiceClient        *storagesync.ServicesClient
 	SyncGroupsClient         *storagesync.SyncGroupsClient
 	SubscriptionId           string
@@ -62,6 +63,9 @@ func NewClient(options *common.ClientOptions) *Client {
 	encryptionScopesClient := storage.NewEncryptionScopesClientWithBaseURI(options.ResourceManagerEndpoint, options.SubscriptionId)
 	options.ConfigureClient(&encryptionScopesClient.Client, options.ResourceManagerAuthorizer)
 
+	objectReplicationClient := storage.NewObjectReplicationPoliciesClientWithBaseURI(options.ResourceManagerEndpoint, options.SubscriptionId)
+	options.ConfigureClient(&objectReplicationClient.Client, options.ResourceManagerAuthorizer)
+
 	syncServiceClient := storagesync.NewServicesClientWithBaseURI(options.ResourceManagerEndpoint, options.SubscriptionId)
 	options.ConfigureClient(&syncServiceClient.Client, options.ResourceManagerAuthorizer)
 
@@ -79,6 +83,7 @@ func NewClient(options *common.ClientOptions) *Client {
 		CloudEndpointsClient:     &cloudEndpointsClient,
 		EncryptionScopesClient:   &encryptionScopesClient,
 		Environment:              options.Environment,
+		ObjectReplicationClient:  &objectReplicationClient,
 		SubscriptionId:           options.SubscriptionId,
 		SyncServiceClient:        &syncServiceClient,
 		SyncGroupsClient:         &syncGroupsClient,
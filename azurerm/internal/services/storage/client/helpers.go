@@ -67,6 +67,23 @@ func (client Client) AddToCache(accountName string, props storage.Account) error
 	return nil
 }
 
+// AddToCacheWithAccountKey caches the Storage Account along with an Account Key which has already been retrieved,
+// so that building the Data Plane clients for this Storage Account doesn't need to list the keys again
+func (client Client) AddToCacheWithAccountKey(accountName string, props storage.Account, accountKey *string) error {
+	accountsLock.Lock()
+	defer accountsLock.Unlock()
+
+	account, err := populateAccountDetails(accountName, props)
+	if err != nil {
+		return err
+	}
+
+	account.accountKey = accountKey
+	storageAccountsCache[accountName] = *account
+
+	return nil
+}
+
 func (client Client) RemoveAccountFromCache(accountName string) {
 	accountsLock.Lock()
 	delete(storageAccountsCache, accountName)
@@ -0,0 +1,54 @@
+package parse
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
+)
+
+var _ resourceid.Formatter = ObjectReplicationId{}
+
+// ObjectReplicationId is the ID of an Object Replication between two Storage Accounts, which is made up of the
+// Object Replication Policy on both the Source and the Destination Storage Account
+type ObjectReplicationId struct {
+	Source      ObjectReplicationPolicyId
+	Destination ObjectReplicationPolicyId
+}
+
+func (id ObjectReplicationId) ID() string {
+	return fmt.Sprintf("%s;%s", id.Source.ID(), id.Destination.ID())
+}
+
+func NewObjectReplicationID(source ObjectReplicationPolicyId, destination ObjectReplicationPolicyId) ObjectReplicationId {
+	return ObjectReplicationId{
+		Source:      source,
+		Destination: destination,
+	}
+}
+
+func ObjectReplicationID(input string) (*ObjectReplicationId, error) {
+	segments := strings.Split(input, ";")
+	if len(segments) != 2 {
+		return nil, fmt.Errorf("expected an ID in the format {sourceObjectReplicationPolicyID};{destinationObjectReplicationPolicyID} but got %q", input)
+	}
+
+	sourceId, err := ObjectReplicationPolicyID(segments[0])
+	if err != nil {
+		return nil, fmt.Errorf("parsing Source Object Replication Policy ID %q: %+v", segments[0], err)
+	}
+
+	destinationId, err := ObjectReplicationPolicyID(segments[1])
+	if err != nil {
+		return nil, fmt.Errorf("parsing Destination Object Replication Policy ID %q: %+v", segments[1], err)
+	}
+
+	if sourceId.Name != destinationId.Name {
+		return nil, fmt.Errorf("expected the Source and Destination Object Replication Policies to have the same name but got %q and %q", sourceId.Name, destinationId.Name)
+	}
+
+	return &ObjectReplicationId{
+		Source:      *sourceId,
+		Destination: *destinationId,
+	}, nil
+}
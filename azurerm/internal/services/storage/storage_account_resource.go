@@ -267,6 +267,18 @@ func resourceStorageAccount() *schema.Resource {
 								},
 							},
 						},
+
+						"versioning_enabled": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Computed: true,
+						},
+
+						"change_feed_enabled": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Computed: true,
+						},
 					},
 				},
 			},
@@ -745,12 +757,12 @@ func resourceStorageAccountCreate(d *schema.ResourceData, meta interface{}) erro
 	log.Printf("[INFO] storage account %q ID: %q", storageAccountName, *account.ID)
 	d.SetId(*account.ID)
 
-	if val, ok := d.GetOk("blob_properties"); ok {
+	if _, ok := d.GetOk("blob_properties"); ok {
 		// FileStorage does not support blob settings
 		if accountKind != string(storage.FileStorage) {
 			blobClient := meta.(*clients.Client).Storage.BlobServicesClient
 
-			blobProperties := expandBlobProperties(val.([]interface{}))
+			blobProperties := expandBlobProperties(d)
 
 			if _, err = blobClient.SetServiceProperties(ctx, resourceGroupName, storageAccountName, blobProperties); err != nil {
 				return fmt.Errorf("Error updating Azure Storage Account `blob_properties` %q: %+v", storageAccountName, err)
@@ -1008,7 +1020,7 @@ func resourceStorageAccountUpdate(d *schema.ResourceData, meta interface{}) erro
 		// FileStorage does not support blob settings
 		if accountKind != string(storage.FileStorage) {
 			blobClient := meta.(*clients.Client).Storage.BlobServicesClient
-			blobProperties := expandBlobProperties(d.Get("blob_properties").([]interface{}))
+			blobProperties := expandBlobProperties(d)
 
 			if _, err = blobClient.SetServiceProperties(ctx, resourceGroupName, storageAccountName, blobProperties); err != nil {
 				return fmt.Errorf("Error updating Azure Storage Account `blob_properties` %q: %+v", storageAccountName, err)
@@ -1223,7 +1235,17 @@ func resourceStorageAccountRead(d *schema.ResourceData, meta interface{}) error
 		return err
 	}
 
+	// we've already retrieved this Storage Account (and potentially its keys) above, so cache these to avoid
+	// listing all of the Storage Accounts in the Subscription (and the keys again) to build the Data Plane clients
 	storageClient := meta.(*clients.Client).Storage
+	var accountKey *string
+	if accessKeys := keys.Keys; accessKeys != nil && len(*accessKeys) > 0 {
+		accountKey = (*accessKeys)[0].Value
+	}
+	if err := storageClient.AddToCacheWithAccountKey(name, resp, accountKey); err != nil {
+		return fmt.Errorf("Error caching Account %q: %s", name, err)
+	}
+
 	account, err := storageClient.FindAccount(ctx, name)
 	if err != nil {
 		return fmt.Errorf("Error retrieving Account %q: %s", name, err)
@@ -1275,13 +1297,6 @@ func resourceStorageAccountRead(d *schema.ResourceData, meta interface{}) error
 
 	// static website only supported on StorageV2 and BlockBlobStorage
 	if resp.Kind == storage.StorageV2 || resp.Kind == storage.BlockBlobStorage {
-		storageClient := meta.(*clients.Client).Storage
-
-		account, err := storageClient.FindAccount(ctx, name)
-		if err != nil {
-			return fmt.Errorf("Error retrieving Account %q: %s", name, err)
-		}
-
 		accountsClient, err := storageClient.AccountsDataPlaneClient(ctx, *account)
 		if err != nil {
 			return fmt.Errorf("Error building Accounts Data Plane Client: %s", err)
@@ -1464,7 +1479,8 @@ func expandStorageAccountBypass(networkRule map[string]interface{}) storage.Bypa
 	return storage.Bypass(strings.Join(bypassValues, ", "))
 }
 
-func expandBlobProperties(input []interface{}) storage.BlobServiceProperties {
+func expandBlobProperties(d *schema.ResourceData) storage.BlobServiceProperties {
+	input := d.Get("blob_properties").([]interface{})
 	props := storage.BlobServiceProperties{
 		BlobServicePropertiesProperties: &storage.BlobServicePropertiesProperties{
 			Cors: &storage.CorsRules{
@@ -1488,6 +1504,19 @@ func expandBlobProperties(input []interface{}) storage.BlobServiceProperties {
 	corsRaw := v["cors_rule"].([]interface{})
 	props.BlobServicePropertiesProperties.Cors = expandBlobPropertiesCors(corsRaw)
 
+	// these are only sent when they're specified, since omitting them leaves versioning and the
+	// change feed as they are - rather than disabling them when they've been enabled outside of Terraform
+	// nolint staticcheck
+	if enabled, ok := d.GetOkExists("blob_properties.0.versioning_enabled"); ok {
+		props.BlobServicePropertiesProperties.IsVersioningEnabled = utils.Bool(enabled.(bool))
+	}
+	// nolint staticcheck
+	if enabled, ok := d.GetOkExists("blob_properties.0.change_feed_enabled"); ok {
+		props.BlobServicePropertiesProperties.ChangeFeed = &storage.ChangeFeed{
+			Enabled: utils.Bool(enabled.(bool)),
+		}
+	}
+
 	return props
 }
 
@@ -1751,7 +1780,17 @@ func flattenBlobProperties(input storage.BlobServiceProperties) []interface{} {
 		flattenedDeletePolicy = flattenBlobPropertiesDeleteRetentionPolicy(deletePolicy)
 	}
 
-	if len(flattenedCorsRules) == 0 && len(flattenedDeletePolicy) == 0 {
+	versioningEnabled := false
+	if v := input.BlobServicePropertiesProperties.IsVersioningEnabled; v != nil {
+		versioningEnabled = *v
+	}
+
+	changeFeedEnabled := false
+	if v := input.BlobServicePropertiesProperties.ChangeFeed; v != nil && v.Enabled != nil {
+		changeFeedEnabled = *v.Enabled
+	}
+
+	if len(flattenedCorsRules) == 0 && len(flattenedDeletePolicy) == 0 && !versioningEnabled && !changeFeedEnabled {
 		return []interface{}{}
 	}
 
@@ -1759,6 +1798,8 @@ func flattenBlobProperties(input storage.BlobServiceProperties) []interface{} {
 		map[string]interface{}{
 			"cors_rule":               flattenedCorsRules,
 			"delete_retention_policy": flattenedDeletePolicy,
+			"versioning_enabled":      versioningEnabled,
+			"change_feed_enabled":     changeFeedEnabled,
 		},
 	}
 }
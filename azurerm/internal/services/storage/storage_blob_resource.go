@@ -127,7 +127,7 @@ func resourceStorageBlob() *schema.Resource {
 			},
 
 			"parallelism": {
-				// TODO: @tombuildsstuff - a note this only works for Page blobs
+				// NOTE: this applies to Page blobs and Block blobs which are uploaded in blocks
 				Type:         schema.TypeInt,
 				Optional:     true,
 				Default:      8,
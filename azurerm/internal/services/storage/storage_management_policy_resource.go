@@ -81,6 +81,33 @@ func resourceStorageManagementPolicy() *schema.Resource {
 										},
 										Set: schema.HashString,
 									},
+									"match_blob_index_tag": {
+										Type:     schema.TypeSet,
+										Optional: true,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"name": {
+													Type:         schema.TypeString,
+													Required:     true,
+													ValidateFunc: validation.StringLenBetween(1, 128),
+												},
+												"operation": {
+													Type:     schema.TypeString,
+													Optional: true,
+													Default:  "==",
+													// only the equality operator is currently supported by the service
+													ValidateFunc: validation.StringInSlice([]string{
+														"==",
+													}, false),
+												},
+												"value": {
+													Type:         schema.TypeString,
+													Required:     true,
+													ValidateFunc: validation.StringLenBetween(0, 256),
+												},
+											},
+										},
+									},
 								},
 							},
 						},
@@ -287,6 +314,10 @@ func expandStorageManagementPolicyRule(d *schema.ResourceData, ruleIndex int) st
 				}
 			}
 			definition.Filters.BlobTypes = &blobTypes
+
+			if blobIndexMatchesRef := filterRef["match_blob_index_tag"].(*schema.Set); blobIndexMatchesRef != nil && blobIndexMatchesRef.Len() > 0 {
+				definition.Filters.BlobIndexMatch = expandStorageManagementPolicyBlobIndexMatch(blobIndexMatchesRef.List())
+			}
 		}
 	}
 	if _, ok := d.GetOk(fmt.Sprintf("rule.%d.actions", ruleIndex)); ok {
@@ -369,6 +400,9 @@ func flattenStorageManagementPolicyRules(armRules *[]storage.ManagementPolicyRul
 					}
 					filter["blob_types"] = blobTypes
 				}
+				if armFilter.BlobIndexMatch != nil {
+					filter["match_blob_index_tag"] = flattenStorageManagementPolicyBlobIndexMatch(armFilter.BlobIndexMatch)
+				}
 				rule["filters"] = [1]interface{}{filter}
 			}
 
@@ -412,3 +446,50 @@ func flattenStorageManagementPolicyRules(armRules *[]storage.ManagementPolicyRul
 
 	return rules
 }
+
+func expandStorageManagementPolicyBlobIndexMatch(input []interface{}) *[]storage.TagFilter {
+	results := make([]storage.TagFilter, 0)
+	for _, v := range input {
+		blobIndexMatch := v.(map[string]interface{})
+
+		results = append(results, storage.TagFilter{
+			Name:  utils.String(blobIndexMatch["name"].(string)),
+			Op:    utils.String(blobIndexMatch["operation"].(string)),
+			Value: utils.String(blobIndexMatch["value"].(string)),
+		})
+	}
+
+	return &results
+}
+
+func flattenStorageManagementPolicyBlobIndexMatch(input *[]storage.TagFilter) []interface{} {
+	results := make([]interface{}, 0)
+	if input == nil {
+		return results
+	}
+
+	for _, v := range *input {
+		name := ""
+		if v.Name != nil {
+			name = *v.Name
+		}
+
+		operation := ""
+		if v.Op != nil {
+			operation = *v.Op
+		}
+
+		value := ""
+		if v.Value != nil {
+			value = *v.Value
+		}
+
+		results = append(results, map[string]interface{}{
+			"name":      name,
+			"operation": operation,
+			"value":     value,
+		})
+	}
+
+	return results
+}
@@ -290,6 +290,24 @@ func TestAccStorageManagementPolicy_blobTypes(t *testing.T) {
 	})
 }
 
+func TestAccStorageManagementPolicy_blobIndexMatch(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_storage_management_policy", "test")
+	r := StorageManagementPolicyResource{}
+
+	data.ResourceTest(t, r, []resource.TestStep{
+		{
+			Config: r.blobIndexMatch(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("rule.#").HasValue("1"),
+				check.That(data.ResourceName).Key("rule.0.filters.#").HasValue("1"),
+				check.That(data.ResourceName).Key("rule.0.filters.0.match_blob_index_tag.#").HasValue("2"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
 func (r StorageManagementPolicyResource) Exists(ctx context.Context, client *clients.Client, state *terraform.InstanceState) (*bool, error) {
 	storageAccountId := state.Attributes["storage_account_id"]
 	id, err := parse.StorageAccountID(storageAccountId)
@@ -611,3 +629,56 @@ resource "azurerm_storage_management_policy" "test" {
 }
 `, data.RandomInteger, data.Locations.Primary, data.RandomString)
 }
+
+func (r StorageManagementPolicyResource) blobIndexMatch(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-storage-%d"
+  location = "%s"
+}
+
+resource "azurerm_storage_account" "test" {
+  name                = "unlikely23exst2acct%s"
+  resource_group_name = azurerm_resource_group.test.name
+
+  location                 = azurerm_resource_group.test.location
+  account_tier             = "Standard"
+  account_replication_type = "LRS"
+  account_kind             = "StorageV2"
+}
+
+resource "azurerm_storage_management_policy" "test" {
+  storage_account_id = azurerm_storage_account.test.id
+
+  rule {
+    name    = "rule1"
+    enabled = true
+    filters {
+      prefix_match = ["container1/prefix1"]
+      blob_types   = ["blockBlob"]
+
+      match_blob_index_tag {
+        name      = "tag1"
+        operation = "=="
+        value     = "val1"
+      }
+
+      match_blob_index_tag {
+        name  = "tag2"
+        value = "val2"
+      }
+    }
+    actions {
+      base_blob {
+        tier_to_cool_after_days_since_modification_greater_than = 10
+        delete_after_days_since_modification_greater_than       = 100
+      }
+    }
+  }
+}
+`, data.RandomInteger, data.Locations.Primary, data.RandomString)
+}
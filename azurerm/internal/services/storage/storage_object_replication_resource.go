@@ -0,0 +1,435 @@
+package storage
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/storage/mgmt/2019-06-01/storage"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/clients"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/storage/parse"
+	storageValidate "github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/storage/validate"
+	azSchema "github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/tf/schema"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/tf/suppress"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/timeouts"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func resourceStorageObjectReplication() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceStorageObjectReplicationCreate,
+		Read:   resourceStorageObjectReplicationRead,
+		Update: resourceStorageObjectReplicationUpdate,
+		Delete: resourceStorageObjectReplicationDelete,
+
+		Importer: azSchema.ValidateResourceIDPriorToImport(func(id string) error {
+			_, err := parse.ObjectReplicationID(id)
+			return err
+		}),
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"source_storage_account_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: storageValidate.StorageAccountID,
+			},
+
+			"destination_storage_account_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: storageValidate.StorageAccountID,
+			},
+
+			"rules": {
+				Type:     schema.TypeList,
+				Required: true,
+				MinItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"source_container_name": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: storageValidate.StorageContainerName,
+						},
+
+						"destination_container_name": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: storageValidate.StorageContainerName,
+						},
+
+						"min_creation_time": {
+							Type:             schema.TypeString,
+							Optional:         true,
+							ValidateFunc:     validation.IsRFC3339Time,
+							DiffSuppressFunc: suppress.RFC3339Time,
+						},
+
+						"filter_out_blobs_with_prefix": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							Elem: &schema.Schema{
+								Type:         schema.TypeString,
+								ValidateFunc: validation.StringIsNotEmpty,
+							},
+						},
+
+						"rule_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+
+			"source_object_replication_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"destination_object_replication_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceStorageObjectReplicationCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Storage.ObjectReplicationClient
+	ctx, cancel := timeouts.ForCreate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	sourceAccountId, err := parse.StorageAccountID(d.Get("source_storage_account_id").(string))
+	if err != nil {
+		return err
+	}
+
+	destinationAccountId, err := parse.StorageAccountID(d.Get("destination_storage_account_id").(string))
+	if err != nil {
+		return err
+	}
+
+	// only a single Object Replication Policy can exist between two Storage Accounts
+	existing, err := findStorageObjectReplicationPolicy(ctx, client, *destinationAccountId, *sourceAccountId)
+	if err != nil {
+		return err
+	}
+	if existing != nil {
+		id := parse.NewObjectReplicationID(
+			parse.NewObjectReplicationPolicyID(sourceAccountId.SubscriptionId, sourceAccountId.ResourceGroup, sourceAccountId.Name, *existing),
+			parse.NewObjectReplicationPolicyID(destinationAccountId.SubscriptionId, destinationAccountId.ResourceGroup, destinationAccountId.Name, *existing),
+		)
+		return tf.ImportAsExistsError("azurerm_storage_object_replication", id.ID())
+	}
+
+	rules, err := expandStorageObjectReplicationRules(d.Get("rules").([]interface{}), nil)
+	if err != nil {
+		return err
+	}
+
+	// the Policy has to be created on the Destination Storage Account first, which generates the Policy ID and
+	// the Rule IDs which are then used to create the Policy on the Source Storage Account
+	props := storage.ObjectReplicationPolicy{
+		ObjectReplicationPolicyProperties: &storage.ObjectReplicationPolicyProperties{
+			SourceAccount:      utils.String(sourceAccountId.Name),
+			DestinationAccount: utils.String(destinationAccountId.Name),
+			Rules:              rules,
+		},
+	}
+	destination, err := client.CreateOrUpdate(ctx, destinationAccountId.ResourceGroup, destinationAccountId.Name, "default", props)
+	if err != nil {
+		return fmt.Errorf("creating Object Replication Policy for Destination %s: %+v", *destinationAccountId, err)
+	}
+	if destination.ObjectReplicationPolicyProperties == nil || destination.ObjectReplicationPolicyProperties.PolicyID == nil {
+		return fmt.Errorf("creating Object Replication Policy for Destination %s: `policyId` was nil", *destinationAccountId)
+	}
+	policyId := *destination.ObjectReplicationPolicyProperties.PolicyID
+
+	props.ObjectReplicationPolicyProperties.Rules = destination.ObjectReplicationPolicyProperties.Rules
+	if _, err := client.CreateOrUpdate(ctx, sourceAccountId.ResourceGroup, sourceAccountId.Name, policyId, props); err != nil {
+		return fmt.Errorf("creating Object Replication Policy %q for Source %s: %+v", policyId, *sourceAccountId, err)
+	}
+
+	id := parse.NewObjectReplicationID(
+		parse.NewObjectReplicationPolicyID(sourceAccountId.SubscriptionId, sourceAccountId.ResourceGroup, sourceAccountId.Name, policyId),
+		parse.NewObjectReplicationPolicyID(destinationAccountId.SubscriptionId, destinationAccountId.ResourceGroup, destinationAccountId.Name, policyId),
+	)
+	d.SetId(id.ID())
+
+	return resourceStorageObjectReplicationRead(d, meta)
+}
+
+func resourceStorageObjectReplicationUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Storage.ObjectReplicationClient
+	ctx, cancel := timeouts.ForUpdate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.ObjectReplicationID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	existing, err := client.Get(ctx, id.Destination.ResourceGroup, id.Destination.StorageAccountName, id.Destination.Name)
+	if err != nil {
+		return fmt.Errorf("retrieving Destination %s: %+v", id.Destination, err)
+	}
+	if existing.ObjectReplicationPolicyProperties == nil {
+		return fmt.Errorf("retrieving Destination %s: `properties` was nil", id.Destination)
+	}
+
+	// existing Rules keep their Rule ID, new Rules are assigned one by the Destination Storage Account
+	rules, err := expandStorageObjectReplicationRules(d.Get("rules").([]interface{}), existing.ObjectReplicationPolicyProperties.Rules)
+	if err != nil {
+		return err
+	}
+
+	props := storage.ObjectReplicationPolicy{
+		ObjectReplicationPolicyProperties: &storage.ObjectReplicationPolicyProperties{
+			SourceAccount:      utils.String(id.Source.StorageAccountName),
+			DestinationAccount: utils.String(id.Destination.StorageAccountName),
+			Rules:              rules,
+		},
+	}
+	destination, err := client.CreateOrUpdate(ctx, id.Destination.ResourceGroup, id.Destination.StorageAccountName, id.Destination.Name, props)
+	if err != nil {
+		return fmt.Errorf("updating Destination %s: %+v", id.Destination, err)
+	}
+	if destination.ObjectReplicationPolicyProperties == nil {
+		return fmt.Errorf("updating Destination %s: `properties` was nil", id.Destination)
+	}
+
+	props.ObjectReplicationPolicyProperties.Rules = destination.ObjectReplicationPolicyProperties.Rules
+	if _, err := client.CreateOrUpdate(ctx, id.Source.ResourceGroup, id.Source.StorageAccountName, id.Source.Name, props); err != nil {
+		return fmt.Errorf("updating Source %s: %+v", id.Source, err)
+	}
+
+	return resourceStorageObjectReplicationRead(d, meta)
+}
+
+func resourceStorageObjectReplicationRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Storage.ObjectReplicationClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.ObjectReplicationID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	destination, err := client.Get(ctx, id.Destination.ResourceGroup, id.Destination.StorageAccountName, id.Destination.Name)
+	if err != nil {
+		if utils.ResponseWasNotFound(destination.Response) {
+			log.Printf("[INFO] Destination %s was not found - removing from state", id.Destination)
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("retrieving Destination %s: %+v", id.Destination, err)
+	}
+
+	source, err := client.Get(ctx, id.Source.ResourceGroup, id.Source.StorageAccountName, id.Source.Name)
+	if err != nil {
+		if utils.ResponseWasNotFound(source.Response) {
+			log.Printf("[INFO] Source %s was not found - removing from state", id.Source)
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("retrieving Source %s: %+v", id.Source, err)
+	}
+
+	d.Set("source_storage_account_id", parse.NewStorageAccountID(id.Source.SubscriptionId, id.Source.ResourceGroup, id.Source.StorageAccountName).ID())
+	d.Set("destination_storage_account_id", parse.NewStorageAccountID(id.Destination.SubscriptionId, id.Destination.ResourceGroup, id.Destination.StorageAccountName).ID())
+	d.Set("source_object_replication_id", id.Source.ID())
+	d.Set("destination_object_replication_id", id.Destination.ID())
+
+	if props := source.ObjectReplicationPolicyProperties; props != nil {
+		if err := d.Set("rules", flattenStorageObjectReplicationRules(props.Rules)); err != nil {
+			return fmt.Errorf("setting `rules`: %+v", err)
+		}
+	}
+
+	return nil
+}
+
+func resourceStorageObjectReplicationDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Storage.ObjectReplicationClient
+	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.ObjectReplicationID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	// the Policy on the Source Storage Account has to be removed first, otherwise replication continues
+	if resp, err := client.Delete(ctx, id.Source.ResourceGroup, id.Source.StorageAccountName, id.Source.Name); err != nil {
+		if !utils.ResponseWasNotFound(resp) {
+			return fmt.Errorf("deleting Source %s: %+v", id.Source, err)
+		}
+	}
+
+	if resp, err := client.Delete(ctx, id.Destination.ResourceGroup, id.Destination.StorageAccountName, id.Destination.Name); err != nil {
+		if !utils.ResponseWasNotFound(resp) {
+			return fmt.Errorf("deleting Destination %s: %+v", id.Destination, err)
+		}
+	}
+
+	return nil
+}
+
+// findStorageObjectReplicationPolicy returns the name of the Object Replication Policy on the Destination
+// Storage Account which replicates from the Source Storage Account, if one exists
+func findStorageObjectReplicationPolicy(ctx context.Context, client *storage.ObjectReplicationPoliciesClient, destination parse.StorageAccountId, source parse.StorageAccountId) (*string, error) {
+	resp, err := client.List(ctx, destination.ResourceGroup, destination.Name)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			return nil, nil
+		}
+
+		return nil, fmt.Errorf("listing Object Replication Policies for Destination %s: %+v", destination, err)
+	}
+
+	if resp.Value == nil {
+		return nil, nil
+	}
+
+	for _, policy := range *resp.Value {
+		props := policy.ObjectReplicationPolicyProperties
+		if props == nil || props.PolicyID == nil || props.SourceAccount == nil {
+			continue
+		}
+
+		if strings.EqualFold(*props.SourceAccount, source.Name) {
+			return props.PolicyID, nil
+		}
+	}
+
+	return nil, nil
+}
+
+// expandStorageObjectReplicationRules builds the Rules for the Object Replication Policy, re-using the Rule ID
+// of any existing Rule which replicates between the same Source and Destination Container
+func expandStorageObjectReplicationRules(input []interface{}, existing *[]storage.ObjectReplicationPolicyRule) (*[]storage.ObjectReplicationPolicyRule, error) {
+	existingRuleIds := make(map[string]string)
+	if existing != nil {
+		for _, rule := range *existing {
+			if rule.RuleID == nil || rule.SourceContainer == nil || rule.DestinationContainer == nil {
+				continue
+			}
+
+			existingRuleIds[storageObjectReplicationRuleKey(*rule.SourceContainer, *rule.DestinationContainer)] = *rule.RuleID
+		}
+	}
+
+	results := make([]storage.ObjectReplicationPolicyRule, 0)
+	seen := make(map[string]struct{})
+	for _, item := range input {
+		v := item.(map[string]interface{})
+
+		sourceContainer := v["source_container_name"].(string)
+		destinationContainer := v["destination_container_name"].(string)
+
+		key := storageObjectReplicationRuleKey(sourceContainer, destinationContainer)
+		if _, exists := seen[key]; exists {
+			return nil, fmt.Errorf("only one `rules` block can replicate from the Container %q to the Container %q", sourceContainer, destinationContainer)
+		}
+		seen[key] = struct{}{}
+
+		filters := &storage.ObjectReplicationPolicyFilter{}
+		hasFilters := false
+		if prefixes := v["filter_out_blobs_with_prefix"].(*schema.Set).List(); len(prefixes) > 0 {
+			filters.PrefixMatch = utils.ExpandStringSlice(prefixes)
+			hasFilters = true
+		}
+		if minCreationTime := v["min_creation_time"].(string); minCreationTime != "" {
+			t, err := time.Parse(time.RFC3339, minCreationTime)
+			if err != nil {
+				return nil, fmt.Errorf("parsing `min_creation_time` %q: %+v", minCreationTime, err)
+			}
+
+			// the API only accepts timestamps in the format `yyyy-MM-ddTHH:mm:ssZ`
+			filters.MinCreationTime = utils.String(t.UTC().Format("2006-01-02T15:04:05Z"))
+			hasFilters = true
+		}
+
+		rule := storage.ObjectReplicationPolicyRule{
+			SourceContainer:      utils.String(sourceContainer),
+			DestinationContainer: utils.String(destinationContainer),
+		}
+		if hasFilters {
+			rule.Filters = filters
+		}
+		if ruleId, ok := existingRuleIds[key]; ok {
+			rule.RuleID = utils.String(ruleId)
+		}
+
+		results = append(results, rule)
+	}
+
+	return &results, nil
+}
+
+func flattenStorageObjectReplicationRules(input *[]storage.ObjectReplicationPolicyRule) []interface{} {
+	results := make([]interface{}, 0)
+	if input == nil {
+		return results
+	}
+
+	for _, rule := range *input {
+		ruleId := ""
+		if rule.RuleID != nil {
+			ruleId = *rule.RuleID
+		}
+
+		sourceContainer := ""
+		if rule.SourceContainer != nil {
+			sourceContainer = *rule.SourceContainer
+		}
+
+		destinationContainer := ""
+		if rule.DestinationContainer != nil {
+			destinationContainer = *rule.DestinationContainer
+		}
+
+		minCreationTime := ""
+		prefixes := make([]interface{}, 0)
+		if filters := rule.Filters; filters != nil {
+			if filters.MinCreationTime != nil {
+				minCreationTime = *filters.MinCreationTime
+			}
+			if filters.PrefixMatch != nil {
+				prefixes = utils.FlattenStringSlice(filters.PrefixMatch)
+			}
+		}
+
+		results = append(results, map[string]interface{}{
+			"rule_id":                      ruleId,
+			"source_container_name":        sourceContainer,
+			"destination_container_name":   destinationContainer,
+			"min_creation_time":            minCreationTime,
+			"filter_out_blobs_with_prefix": schema.NewSet(schema.HashString, prefixes),
+		})
+	}
+
+	return results
+}
+
+func storageObjectReplicationRuleKey(sourceContainer, destinationContainer string) string {
+	return fmt.Sprintf("%s/%s", strings.ToLower(sourceContainer), strings.ToLower(destinationContainer))
+}
@@ -130,7 +130,29 @@ func resourceAppServicePlan() *schema.Resource {
 
 			"tags": tags.Schema(),
 		},
+
+		CustomizeDiff: resourceAppServicePlanCustomizeDiff,
+	}
+}
+
+func resourceAppServicePlanCustomizeDiff(d *schema.ResourceDiff, _ interface{}) error {
+	if !d.NewValueKnown("sku.0.tier") || !d.NewValueKnown("maximum_elastic_worker_count") {
+		return nil
+	}
+
+	isElastic := strings.EqualFold(d.Get("sku.0.tier").(string), "ElasticPremium")
+
+	// the API silently ignores the Maximum Elastic Worker Count for non-Elastic SKUs (and returns `1` for these)
+	// so this is only validated when either it or the tier changes, since otherwise the value is the one from the state
+	if !d.HasChange("maximum_elastic_worker_count") && !d.HasChange("sku.0.tier") {
+		return nil
+	}
+
+	if v := d.Get("maximum_elastic_worker_count").(int); v > 1 && !isElastic {
+		return fmt.Errorf("`maximum_elastic_worker_count` can only be specified when the `sku` tier is `ElasticPremium` - when changing the `sku` tier away from `ElasticPremium` this must be set to `1`")
 	}
+
+	return nil
 }
 
 func resourceAppServicePlanCreateUpdate(d *schema.ResourceData, meta interface{}) error {
@@ -184,9 +206,8 @@ func resourceAppServicePlanCreateUpdate(d *schema.ResourceData, meta interface{}
 		}
 	}
 
-	if v := d.Get("per_site_scaling").(bool); v {
-		appServicePlan.AppServicePlanProperties.PerSiteScaling = utils.Bool(v)
-	}
+	// this is always sent so that Per Site Scaling can be disabled in-place
+	appServicePlan.AppServicePlanProperties.PerSiteScaling = utils.Bool(d.Get("per_site_scaling").(bool))
 
 	reserved := d.Get("reserved").(bool)
 	if strings.EqualFold(kind, "Linux") && !reserved {
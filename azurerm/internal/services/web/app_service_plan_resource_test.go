@@ -3,6 +3,7 @@ package web_test
 import (
 	"context"
 	"fmt"
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
@@ -149,6 +150,30 @@ func TestAccAppServicePlan_completeWindows(t *testing.T) {
 	})
 }
 
+func TestAccAppServicePlan_perSiteScalingUpdate(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_app_service_plan", "test")
+	r := AppServicePlanResource{}
+
+	data.ResourceTest(t, r, []resource.TestStep{
+		{
+			Config: r.perSiteScaling(data, true),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("per_site_scaling").HasValue("true"),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.perSiteScaling(data, false),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("per_site_scaling").HasValue("false"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
 func TestAccAppServicePlan_consumptionPlan(t *testing.T) {
 	data := acceptance.BuildTestData(t, "azurerm_app_service_plan", "test")
 	r := AppServicePlanResource{}
@@ -197,6 +222,54 @@ func TestAccAppServicePlan_premiumConsumptionPlan(t *testing.T) {
 	})
 }
 
+func TestAccAppServicePlan_maximumElasticWorkerCountNonElastic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_app_service_plan", "test")
+	r := AppServicePlanResource{}
+
+	data.ResourceTest(t, r, []resource.TestStep{
+		{
+			Config: r.perSiteScaling(data, false),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config:      r.maximumElasticWorkerCountNonElastic(data),
+			ExpectError: regexp.MustCompile("`maximum_elastic_worker_count` can only be specified when the `sku` tier is `ElasticPremium`"),
+		},
+	})
+}
+
+func TestAccAppServicePlan_maximumElasticWorkerCountOnTierChange(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_app_service_plan", "test")
+	r := AppServicePlanResource{}
+
+	data.ResourceTest(t, r, []resource.TestStep{
+		{
+			Config: r.premiumConsumptionPlan(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("maximum_elastic_worker_count").HasValue("20"),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config:      r.elasticPremiumV2(data, 20),
+			ExpectError: regexp.MustCompile("`maximum_elastic_worker_count` can only be specified when the `sku` tier is `ElasticPremium`"),
+		},
+		{
+			Config: r.elasticPremiumV2(data, 1),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("sku.0.tier").HasValue("PremiumV2"),
+				check.That(data.ResourceName).Key("maximum_elastic_worker_count").HasValue("1"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
 func TestAccAppServicePlan_basicWindowsContainer(t *testing.T) {
 	data := acceptance.BuildTestData(t, "azurerm_app_service_plan", "test")
 	r := AppServicePlanResource{}
@@ -474,6 +547,58 @@ resource "azurerm_app_service_plan" "test" {
 `, data.RandomInteger, data.Locations.Primary, data.RandomInteger)
 }
 
+func (r AppServicePlanResource) perSiteScaling(data acceptance.TestData, enabled bool) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_app_service_plan" "test" {
+  name                = "acctestASP-%d"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+
+  sku {
+    tier = "Standard"
+    size = "S1"
+  }
+
+  per_site_scaling = %t
+}
+`, data.RandomInteger, data.Locations.Primary, data.RandomInteger, enabled)
+}
+
+func (r AppServicePlanResource) maximumElasticWorkerCountNonElastic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_app_service_plan" "test" {
+  name                = "acctestASP-%d"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+
+  maximum_elastic_worker_count = 5
+
+  sku {
+    tier = "Premium"
+    size = "P1"
+  }
+}
+`, data.RandomInteger, data.Locations.Primary, data.RandomInteger)
+}
+
 func (r AppServicePlanResource) consumptionPlan(data acceptance.TestData) string {
 	return fmt.Sprintf(`
 provider "azurerm" {
@@ -552,6 +677,33 @@ resource "azurerm_app_service_plan" "test" {
 `, data.RandomInteger, data.Locations.Primary, data.RandomInteger)
 }
 
+func (r AppServicePlanResource) elasticPremiumV2(data acceptance.TestData, maximumElasticWorkerCount int) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_app_service_plan" "test" {
+  name                = "acctestASP-%d"
+  resource_group_name = azurerm_resource_group.test.name
+  location            = azurerm_resource_group.test.location
+  kind                = "elastic"
+
+  maximum_elastic_worker_count = %d
+
+  sku {
+    tier = "PremiumV2"
+    size = "P1v2"
+  }
+}
+`, data.RandomInteger, data.Locations.Primary, data.RandomInteger, maximumElasticWorkerCount)
+}
+
 func (r AppServicePlanResource) basicWindowsContainer(data acceptance.TestData) string {
 	return fmt.Sprintf(`
 provider "azurerm" {